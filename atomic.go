@@ -0,0 +1,892 @@
+package gost
+
+import (
+	"fmt"
+	"sync/atomic"
+)
+
+// The error returned by CompareExchange when the atomic did not hold the expected value, and by FetchUpdate when the function returned None.
+// It carries the value the atomic held.
+type CompareExchangeError[T any] struct {
+	actual T
+}
+
+// Returns the value the atomic held.
+func (self CompareExchangeError[T]) Actual() T {
+	return self.actual
+}
+
+// impl error for CompareExchangeError
+func (self CompareExchangeError[T]) Error() string {
+	return fmt.Sprintf("atomic value was %v", self.actual)
+}
+
+// An integer type which can be safely shared between threads, holding a I32.
+// All operations are sequentially consistent, as with sync/atomic. Its zero value holds 0, and it must not be copied after first use.
+//
+//	counter := gost.AtomicI32New(0)
+//	counter.FetchAdd(1)
+//	gost.AssertEq(counter.Load(), gost.I32(1))
+type AtomicI32 struct {
+	value atomic.Int32
+}
+
+// Creates a new atomic integer holding value.
+func AtomicI32New(value I32) *AtomicI32 {
+	result := &AtomicI32{}
+	result.Store(value)
+	return result
+}
+
+// Loads the value.
+func (self *AtomicI32) Load() I32 {
+	return I32(self.value.Load())
+}
+
+// Stores value.
+func (self *AtomicI32) Store(value I32) {
+	self.value.Store(int32(value))
+}
+
+// Stores value, returning the previous value.
+func (self *AtomicI32) Swap(value I32) I32 {
+	return I32(self.value.Swap(int32(value)))
+}
+
+// Stores new if the value is current.
+// Returns Ok with the previous value, which is current, or an Err of CompareExchangeError with the value that was found instead.
+//
+//	value := gost.AtomicI32New(5)
+//	gost.AssertEq(value.CompareExchange(5, 10), gost.Ok[gost.I32](5))
+//	gost.Assert(value.CompareExchange(6, 12).IsErr())
+func (self *AtomicI32) CompareExchange(current I32, new I32) Result[I32] {
+	for {
+		actual := self.Load()
+
+		if actual != current {
+			return Err[I32](CompareExchangeError[I32]{actual: actual})
+		}
+
+		if self.value.CompareAndSwap(int32(current), int32(new)) {
+			return Ok(current)
+		}
+	}
+}
+
+// Applies f to the value until it is stored without another thread changing the value in between.
+func (self *AtomicI32) fetchOp(f func(I32) I32) I32 {
+	for {
+		previous := self.Load()
+
+		if self.value.CompareAndSwap(int32(previous), int32(f(previous))) {
+			return previous
+		}
+	}
+}
+
+// Adds to the value, wrapping around on overflow, and returns the previous value.
+func (self *AtomicI32) FetchAdd(value I32) I32 {
+	return I32(self.value.Add(int32(value))) - value
+}
+
+// Subtracts from the value, wrapping around on overflow, and returns the previous value.
+func (self *AtomicI32) FetchSub(value I32) I32 {
+	return self.fetchOp(func(previous I32) I32 { return previous - value })
+}
+
+// Bitwise "and" with the value, returning the previous value.
+func (self *AtomicI32) FetchAnd(value I32) I32 {
+	return self.fetchOp(func(previous I32) I32 { return previous & value })
+}
+
+// Bitwise "or" with the value, returning the previous value.
+func (self *AtomicI32) FetchOr(value I32) I32 {
+	return self.fetchOp(func(previous I32) I32 { return previous | value })
+}
+
+// Bitwise "xor" with the value, returning the previous value.
+func (self *AtomicI32) FetchXor(value I32) I32 {
+	return self.fetchOp(func(previous I32) I32 { return previous ^ value })
+}
+
+// Stores the maximum of the value and the argument, returning the previous value.
+func (self *AtomicI32) FetchMax(value I32) I32 {
+	return self.fetchOp(func(previous I32) I32 {
+		if value > previous {
+			return value
+		}
+		return previous
+	})
+}
+
+// Stores the minimum of the value and the argument, returning the previous value.
+func (self *AtomicI32) FetchMin(value I32) I32 {
+	return self.fetchOp(func(previous I32) I32 {
+		if value < previous {
+			return value
+		}
+		return previous
+	})
+}
+
+// Stores the result of f applied to the value, retrying if another thread changes the value in between, so f may be called several times.
+// Returns Ok with the previous value, or an Err of CompareExchangeError with the value if f returned None.
+//
+//	value := gost.AtomicI32New(7)
+//	value.FetchUpdate(func(x gost.I32) gost.Option[gost.I32] { return gost.Some(x + 1) })
+//	gost.AssertEq(value.Load(), gost.I32(8))
+func (self *AtomicI32) FetchUpdate(f func(I32) Option[I32]) Result[I32] {
+	for {
+		previous := self.Load()
+		next := f(previous)
+
+		if next.IsNone() {
+			return Err[I32](CompareExchangeError[I32]{actual: previous})
+		}
+
+		if self.value.CompareAndSwap(int32(previous), int32(next.Unwrap())) {
+			return Ok(previous)
+		}
+	}
+}
+
+// impl Display for AtomicI32
+func (self *AtomicI32) Display() String {
+	return self.Load().Display()
+}
+
+// impl Debug for AtomicI32
+func (self *AtomicI32) Debug() String {
+	return String(fmt.Sprintf("AtomicI32(%s)", self.Load().ToString()))
+}
+
+// An integer type which can be safely shared between threads, holding a I64.
+// All operations are sequentially consistent, as with sync/atomic. Its zero value holds 0, and it must not be copied after first use.
+//
+//	counter := gost.AtomicI64New(0)
+//	counter.FetchAdd(1)
+//	gost.AssertEq(counter.Load(), gost.I64(1))
+type AtomicI64 struct {
+	value atomic.Int64
+}
+
+// Creates a new atomic integer holding value.
+func AtomicI64New(value I64) *AtomicI64 {
+	result := &AtomicI64{}
+	result.Store(value)
+	return result
+}
+
+// Loads the value.
+func (self *AtomicI64) Load() I64 {
+	return I64(self.value.Load())
+}
+
+// Stores value.
+func (self *AtomicI64) Store(value I64) {
+	self.value.Store(int64(value))
+}
+
+// Stores value, returning the previous value.
+func (self *AtomicI64) Swap(value I64) I64 {
+	return I64(self.value.Swap(int64(value)))
+}
+
+// Stores new if the value is current.
+// Returns Ok with the previous value, which is current, or an Err of CompareExchangeError with the value that was found instead.
+//
+//	value := gost.AtomicI64New(5)
+//	gost.AssertEq(value.CompareExchange(5, 10), gost.Ok[gost.I64](5))
+//	gost.Assert(value.CompareExchange(6, 12).IsErr())
+func (self *AtomicI64) CompareExchange(current I64, new I64) Result[I64] {
+	for {
+		actual := self.Load()
+
+		if actual != current {
+			return Err[I64](CompareExchangeError[I64]{actual: actual})
+		}
+
+		if self.value.CompareAndSwap(int64(current), int64(new)) {
+			return Ok(current)
+		}
+	}
+}
+
+// Applies f to the value until it is stored without another thread changing the value in between.
+func (self *AtomicI64) fetchOp(f func(I64) I64) I64 {
+	for {
+		previous := self.Load()
+
+		if self.value.CompareAndSwap(int64(previous), int64(f(previous))) {
+			return previous
+		}
+	}
+}
+
+// Adds to the value, wrapping around on overflow, and returns the previous value.
+func (self *AtomicI64) FetchAdd(value I64) I64 {
+	return I64(self.value.Add(int64(value))) - value
+}
+
+// Subtracts from the value, wrapping around on overflow, and returns the previous value.
+func (self *AtomicI64) FetchSub(value I64) I64 {
+	return self.fetchOp(func(previous I64) I64 { return previous - value })
+}
+
+// Bitwise "and" with the value, returning the previous value.
+func (self *AtomicI64) FetchAnd(value I64) I64 {
+	return self.fetchOp(func(previous I64) I64 { return previous & value })
+}
+
+// Bitwise "or" with the value, returning the previous value.
+func (self *AtomicI64) FetchOr(value I64) I64 {
+	return self.fetchOp(func(previous I64) I64 { return previous | value })
+}
+
+// Bitwise "xor" with the value, returning the previous value.
+func (self *AtomicI64) FetchXor(value I64) I64 {
+	return self.fetchOp(func(previous I64) I64 { return previous ^ value })
+}
+
+// Stores the maximum of the value and the argument, returning the previous value.
+func (self *AtomicI64) FetchMax(value I64) I64 {
+	return self.fetchOp(func(previous I64) I64 {
+		if value > previous {
+			return value
+		}
+		return previous
+	})
+}
+
+// Stores the minimum of the value and the argument, returning the previous value.
+func (self *AtomicI64) FetchMin(value I64) I64 {
+	return self.fetchOp(func(previous I64) I64 {
+		if value < previous {
+			return value
+		}
+		return previous
+	})
+}
+
+// Stores the result of f applied to the value, retrying if another thread changes the value in between, so f may be called several times.
+// Returns Ok with the previous value, or an Err of CompareExchangeError with the value if f returned None.
+//
+//	value := gost.AtomicI64New(7)
+//	value.FetchUpdate(func(x gost.I64) gost.Option[gost.I64] { return gost.Some(x + 1) })
+//	gost.AssertEq(value.Load(), gost.I64(8))
+func (self *AtomicI64) FetchUpdate(f func(I64) Option[I64]) Result[I64] {
+	for {
+		previous := self.Load()
+		next := f(previous)
+
+		if next.IsNone() {
+			return Err[I64](CompareExchangeError[I64]{actual: previous})
+		}
+
+		if self.value.CompareAndSwap(int64(previous), int64(next.Unwrap())) {
+			return Ok(previous)
+		}
+	}
+}
+
+// impl Display for AtomicI64
+func (self *AtomicI64) Display() String {
+	return self.Load().Display()
+}
+
+// impl Debug for AtomicI64
+func (self *AtomicI64) Debug() String {
+	return String(fmt.Sprintf("AtomicI64(%s)", self.Load().ToString()))
+}
+
+// An integer type which can be safely shared between threads, holding a U32.
+// All operations are sequentially consistent, as with sync/atomic. Its zero value holds 0, and it must not be copied after first use.
+//
+//	counter := gost.AtomicU32New(0)
+//	counter.FetchAdd(1)
+//	gost.AssertEq(counter.Load(), gost.U32(1))
+type AtomicU32 struct {
+	value atomic.Uint32
+}
+
+// Creates a new atomic integer holding value.
+func AtomicU32New(value U32) *AtomicU32 {
+	result := &AtomicU32{}
+	result.Store(value)
+	return result
+}
+
+// Loads the value.
+func (self *AtomicU32) Load() U32 {
+	return U32(self.value.Load())
+}
+
+// Stores value.
+func (self *AtomicU32) Store(value U32) {
+	self.value.Store(uint32(value))
+}
+
+// Stores value, returning the previous value.
+func (self *AtomicU32) Swap(value U32) U32 {
+	return U32(self.value.Swap(uint32(value)))
+}
+
+// Stores new if the value is current.
+// Returns Ok with the previous value, which is current, or an Err of CompareExchangeError with the value that was found instead.
+//
+//	value := gost.AtomicU32New(5)
+//	gost.AssertEq(value.CompareExchange(5, 10), gost.Ok[gost.U32](5))
+//	gost.Assert(value.CompareExchange(6, 12).IsErr())
+func (self *AtomicU32) CompareExchange(current U32, new U32) Result[U32] {
+	for {
+		actual := self.Load()
+
+		if actual != current {
+			return Err[U32](CompareExchangeError[U32]{actual: actual})
+		}
+
+		if self.value.CompareAndSwap(uint32(current), uint32(new)) {
+			return Ok(current)
+		}
+	}
+}
+
+// Applies f to the value until it is stored without another thread changing the value in between.
+func (self *AtomicU32) fetchOp(f func(U32) U32) U32 {
+	for {
+		previous := self.Load()
+
+		if self.value.CompareAndSwap(uint32(previous), uint32(f(previous))) {
+			return previous
+		}
+	}
+}
+
+// Adds to the value, wrapping around on overflow, and returns the previous value.
+func (self *AtomicU32) FetchAdd(value U32) U32 {
+	return U32(self.value.Add(uint32(value))) - value
+}
+
+// Subtracts from the value, wrapping around on overflow, and returns the previous value.
+func (self *AtomicU32) FetchSub(value U32) U32 {
+	return self.fetchOp(func(previous U32) U32 { return previous - value })
+}
+
+// Bitwise "and" with the value, returning the previous value.
+func (self *AtomicU32) FetchAnd(value U32) U32 {
+	return self.fetchOp(func(previous U32) U32 { return previous & value })
+}
+
+// Bitwise "or" with the value, returning the previous value.
+func (self *AtomicU32) FetchOr(value U32) U32 {
+	return self.fetchOp(func(previous U32) U32 { return previous | value })
+}
+
+// Bitwise "xor" with the value, returning the previous value.
+func (self *AtomicU32) FetchXor(value U32) U32 {
+	return self.fetchOp(func(previous U32) U32 { return previous ^ value })
+}
+
+// Stores the maximum of the value and the argument, returning the previous value.
+func (self *AtomicU32) FetchMax(value U32) U32 {
+	return self.fetchOp(func(previous U32) U32 {
+		if value > previous {
+			return value
+		}
+		return previous
+	})
+}
+
+// Stores the minimum of the value and the argument, returning the previous value.
+func (self *AtomicU32) FetchMin(value U32) U32 {
+	return self.fetchOp(func(previous U32) U32 {
+		if value < previous {
+			return value
+		}
+		return previous
+	})
+}
+
+// Stores the result of f applied to the value, retrying if another thread changes the value in between, so f may be called several times.
+// Returns Ok with the previous value, or an Err of CompareExchangeError with the value if f returned None.
+//
+//	value := gost.AtomicU32New(7)
+//	value.FetchUpdate(func(x gost.U32) gost.Option[gost.U32] { return gost.Some(x + 1) })
+//	gost.AssertEq(value.Load(), gost.U32(8))
+func (self *AtomicU32) FetchUpdate(f func(U32) Option[U32]) Result[U32] {
+	for {
+		previous := self.Load()
+		next := f(previous)
+
+		if next.IsNone() {
+			return Err[U32](CompareExchangeError[U32]{actual: previous})
+		}
+
+		if self.value.CompareAndSwap(uint32(previous), uint32(next.Unwrap())) {
+			return Ok(previous)
+		}
+	}
+}
+
+// impl Display for AtomicU32
+func (self *AtomicU32) Display() String {
+	return self.Load().Display()
+}
+
+// impl Debug for AtomicU32
+func (self *AtomicU32) Debug() String {
+	return String(fmt.Sprintf("AtomicU32(%s)", self.Load().ToString()))
+}
+
+// An integer type which can be safely shared between threads, holding a U64.
+// All operations are sequentially consistent, as with sync/atomic. Its zero value holds 0, and it must not be copied after first use.
+//
+//	counter := gost.AtomicU64New(0)
+//	counter.FetchAdd(1)
+//	gost.AssertEq(counter.Load(), gost.U64(1))
+type AtomicU64 struct {
+	value atomic.Uint64
+}
+
+// Creates a new atomic integer holding value.
+func AtomicU64New(value U64) *AtomicU64 {
+	result := &AtomicU64{}
+	result.Store(value)
+	return result
+}
+
+// Loads the value.
+func (self *AtomicU64) Load() U64 {
+	return U64(self.value.Load())
+}
+
+// Stores value.
+func (self *AtomicU64) Store(value U64) {
+	self.value.Store(uint64(value))
+}
+
+// Stores value, returning the previous value.
+func (self *AtomicU64) Swap(value U64) U64 {
+	return U64(self.value.Swap(uint64(value)))
+}
+
+// Stores new if the value is current.
+// Returns Ok with the previous value, which is current, or an Err of CompareExchangeError with the value that was found instead.
+//
+//	value := gost.AtomicU64New(5)
+//	gost.AssertEq(value.CompareExchange(5, 10), gost.Ok[gost.U64](5))
+//	gost.Assert(value.CompareExchange(6, 12).IsErr())
+func (self *AtomicU64) CompareExchange(current U64, new U64) Result[U64] {
+	for {
+		actual := self.Load()
+
+		if actual != current {
+			return Err[U64](CompareExchangeError[U64]{actual: actual})
+		}
+
+		if self.value.CompareAndSwap(uint64(current), uint64(new)) {
+			return Ok(current)
+		}
+	}
+}
+
+// Applies f to the value until it is stored without another thread changing the value in between.
+func (self *AtomicU64) fetchOp(f func(U64) U64) U64 {
+	for {
+		previous := self.Load()
+
+		if self.value.CompareAndSwap(uint64(previous), uint64(f(previous))) {
+			return previous
+		}
+	}
+}
+
+// Adds to the value, wrapping around on overflow, and returns the previous value.
+func (self *AtomicU64) FetchAdd(value U64) U64 {
+	return U64(self.value.Add(uint64(value))) - value
+}
+
+// Subtracts from the value, wrapping around on overflow, and returns the previous value.
+func (self *AtomicU64) FetchSub(value U64) U64 {
+	return self.fetchOp(func(previous U64) U64 { return previous - value })
+}
+
+// Bitwise "and" with the value, returning the previous value.
+func (self *AtomicU64) FetchAnd(value U64) U64 {
+	return self.fetchOp(func(previous U64) U64 { return previous & value })
+}
+
+// Bitwise "or" with the value, returning the previous value.
+func (self *AtomicU64) FetchOr(value U64) U64 {
+	return self.fetchOp(func(previous U64) U64 { return previous | value })
+}
+
+// Bitwise "xor" with the value, returning the previous value.
+func (self *AtomicU64) FetchXor(value U64) U64 {
+	return self.fetchOp(func(previous U64) U64 { return previous ^ value })
+}
+
+// Stores the maximum of the value and the argument, returning the previous value.
+func (self *AtomicU64) FetchMax(value U64) U64 {
+	return self.fetchOp(func(previous U64) U64 {
+		if value > previous {
+			return value
+		}
+		return previous
+	})
+}
+
+// Stores the minimum of the value and the argument, returning the previous value.
+func (self *AtomicU64) FetchMin(value U64) U64 {
+	return self.fetchOp(func(previous U64) U64 {
+		if value < previous {
+			return value
+		}
+		return previous
+	})
+}
+
+// Stores the result of f applied to the value, retrying if another thread changes the value in between, so f may be called several times.
+// Returns Ok with the previous value, or an Err of CompareExchangeError with the value if f returned None.
+//
+//	value := gost.AtomicU64New(7)
+//	value.FetchUpdate(func(x gost.U64) gost.Option[gost.U64] { return gost.Some(x + 1) })
+//	gost.AssertEq(value.Load(), gost.U64(8))
+func (self *AtomicU64) FetchUpdate(f func(U64) Option[U64]) Result[U64] {
+	for {
+		previous := self.Load()
+		next := f(previous)
+
+		if next.IsNone() {
+			return Err[U64](CompareExchangeError[U64]{actual: previous})
+		}
+
+		if self.value.CompareAndSwap(uint64(previous), uint64(next.Unwrap())) {
+			return Ok(previous)
+		}
+	}
+}
+
+// impl Display for AtomicU64
+func (self *AtomicU64) Display() String {
+	return self.Load().Display()
+}
+
+// impl Debug for AtomicU64
+func (self *AtomicU64) Debug() String {
+	return String(fmt.Sprintf("AtomicU64(%s)", self.Load().ToString()))
+}
+
+// An integer type which can be safely shared between threads, holding a USize.
+// All operations are sequentially consistent, as with sync/atomic. Its zero value holds 0, and it must not be copied after first use.
+//
+//	counter := gost.AtomicUSizeNew(0)
+//	counter.FetchAdd(1)
+//	gost.AssertEq(counter.Load(), gost.USize(1))
+type AtomicUSize struct {
+	value atomic.Uintptr
+}
+
+// Creates a new atomic integer holding value.
+func AtomicUSizeNew(value USize) *AtomicUSize {
+	result := &AtomicUSize{}
+	result.Store(value)
+	return result
+}
+
+// Loads the value.
+func (self *AtomicUSize) Load() USize {
+	return USize(self.value.Load())
+}
+
+// Stores value.
+func (self *AtomicUSize) Store(value USize) {
+	self.value.Store(uintptr(value))
+}
+
+// Stores value, returning the previous value.
+func (self *AtomicUSize) Swap(value USize) USize {
+	return USize(self.value.Swap(uintptr(value)))
+}
+
+// Stores new if the value is current.
+// Returns Ok with the previous value, which is current, or an Err of CompareExchangeError with the value that was found instead.
+//
+//	value := gost.AtomicUSizeNew(5)
+//	gost.AssertEq(value.CompareExchange(5, 10), gost.Ok[gost.USize](5))
+//	gost.Assert(value.CompareExchange(6, 12).IsErr())
+func (self *AtomicUSize) CompareExchange(current USize, new USize) Result[USize] {
+	for {
+		actual := self.Load()
+
+		if actual != current {
+			return Err[USize](CompareExchangeError[USize]{actual: actual})
+		}
+
+		if self.value.CompareAndSwap(uintptr(current), uintptr(new)) {
+			return Ok(current)
+		}
+	}
+}
+
+// Applies f to the value until it is stored without another thread changing the value in between.
+func (self *AtomicUSize) fetchOp(f func(USize) USize) USize {
+	for {
+		previous := self.Load()
+
+		if self.value.CompareAndSwap(uintptr(previous), uintptr(f(previous))) {
+			return previous
+		}
+	}
+}
+
+// Adds to the value, wrapping around on overflow, and returns the previous value.
+func (self *AtomicUSize) FetchAdd(value USize) USize {
+	return USize(self.value.Add(uintptr(value))) - value
+}
+
+// Subtracts from the value, wrapping around on overflow, and returns the previous value.
+func (self *AtomicUSize) FetchSub(value USize) USize {
+	return self.fetchOp(func(previous USize) USize { return previous - value })
+}
+
+// Bitwise "and" with the value, returning the previous value.
+func (self *AtomicUSize) FetchAnd(value USize) USize {
+	return self.fetchOp(func(previous USize) USize { return previous & value })
+}
+
+// Bitwise "or" with the value, returning the previous value.
+func (self *AtomicUSize) FetchOr(value USize) USize {
+	return self.fetchOp(func(previous USize) USize { return previous | value })
+}
+
+// Bitwise "xor" with the value, returning the previous value.
+func (self *AtomicUSize) FetchXor(value USize) USize {
+	return self.fetchOp(func(previous USize) USize { return previous ^ value })
+}
+
+// Stores the maximum of the value and the argument, returning the previous value.
+func (self *AtomicUSize) FetchMax(value USize) USize {
+	return self.fetchOp(func(previous USize) USize {
+		if value > previous {
+			return value
+		}
+		return previous
+	})
+}
+
+// Stores the minimum of the value and the argument, returning the previous value.
+func (self *AtomicUSize) FetchMin(value USize) USize {
+	return self.fetchOp(func(previous USize) USize {
+		if value < previous {
+			return value
+		}
+		return previous
+	})
+}
+
+// Stores the result of f applied to the value, retrying if another thread changes the value in between, so f may be called several times.
+// Returns Ok with the previous value, or an Err of CompareExchangeError with the value if f returned None.
+//
+//	value := gost.AtomicUSizeNew(7)
+//	value.FetchUpdate(func(x gost.USize) gost.Option[gost.USize] { return gost.Some(x + 1) })
+//	gost.AssertEq(value.Load(), gost.USize(8))
+func (self *AtomicUSize) FetchUpdate(f func(USize) Option[USize]) Result[USize] {
+	for {
+		previous := self.Load()
+		next := f(previous)
+
+		if next.IsNone() {
+			return Err[USize](CompareExchangeError[USize]{actual: previous})
+		}
+
+		if self.value.CompareAndSwap(uintptr(previous), uintptr(next.Unwrap())) {
+			return Ok(previous)
+		}
+	}
+}
+
+// impl Display for AtomicUSize
+func (self *AtomicUSize) Display() String {
+	return self.Load().Display()
+}
+
+// impl Debug for AtomicUSize
+func (self *AtomicUSize) Debug() String {
+	return String(fmt.Sprintf("AtomicUSize(%s)", self.Load().ToString()))
+}
+
+// A boolean type which can be safely shared between threads.
+// All operations are sequentially consistent, as with sync/atomic. Its zero value holds false, and it must not be copied after first use.
+//
+//	stop := gost.AtomicBoolNew(false)
+//	stop.Store(true)
+//	gost.Assert(stop.Load())
+type AtomicBool struct {
+	value atomic.Bool
+}
+
+// Creates a new atomic boolean holding value.
+func AtomicBoolNew(value Bool) *AtomicBool {
+	result := &AtomicBool{}
+	result.Store(value)
+	return result
+}
+
+// Loads the value.
+func (self *AtomicBool) Load() Bool {
+	return Bool(self.value.Load())
+}
+
+// Stores value.
+func (self *AtomicBool) Store(value Bool) {
+	self.value.Store(bool(value))
+}
+
+// Stores value, returning the previous value.
+func (self *AtomicBool) Swap(value Bool) Bool {
+	return Bool(self.value.Swap(bool(value)))
+}
+
+// Stores new if the value is current.
+// Returns Ok with the previous value, which is current, or an Err of CompareExchangeError with the value that was found instead.
+func (self *AtomicBool) CompareExchange(current Bool, new Bool) Result[Bool] {
+	if self.value.CompareAndSwap(bool(current), bool(new)) {
+		return Ok(current)
+	}
+
+	// A boolean that is not current can only be its negation.
+	return Err[Bool](CompareExchangeError[Bool]{actual: !current})
+}
+
+func (self *AtomicBool) fetchOp(f func(Bool) Bool) Bool {
+	for {
+		previous := self.Load()
+
+		if self.value.CompareAndSwap(bool(previous), bool(f(previous))) {
+			return previous
+		}
+	}
+}
+
+// Logical "and" with the value, returning the previous value.
+func (self *AtomicBool) FetchAnd(value Bool) Bool {
+	return self.fetchOp(func(previous Bool) Bool { return previous && value })
+}
+
+// Logical "nand" with the value, returning the previous value.
+func (self *AtomicBool) FetchNand(value Bool) Bool {
+	return self.fetchOp(func(previous Bool) Bool { return !(previous && value) })
+}
+
+// Logical "or" with the value, returning the previous value.
+func (self *AtomicBool) FetchOr(value Bool) Bool {
+	return self.fetchOp(func(previous Bool) Bool { return previous || value })
+}
+
+// Logical "xor" with the value, returning the previous value.
+func (self *AtomicBool) FetchXor(value Bool) Bool {
+	return self.fetchOp(func(previous Bool) Bool { return previous != value })
+}
+
+// Stores the result of f applied to the value, retrying if another thread changes the value in between, so f may be called several times.
+// Returns Ok with the previous value, or an Err of CompareExchangeError with the value if f returned None.
+func (self *AtomicBool) FetchUpdate(f func(Bool) Option[Bool]) Result[Bool] {
+	for {
+		previous := self.Load()
+		next := f(previous)
+
+		if next.IsNone() {
+			return Err[Bool](CompareExchangeError[Bool]{actual: previous})
+		}
+
+		if self.value.CompareAndSwap(bool(previous), bool(next.Unwrap())) {
+			return Ok(previous)
+		}
+	}
+}
+
+// impl Display for AtomicBool
+func (self *AtomicBool) Display() String {
+	return self.Load().Display()
+}
+
+// impl Debug for AtomicBool
+func (self *AtomicBool) Debug() String {
+	return String(fmt.Sprintf("AtomicBool(%s)", self.Load().ToString()))
+}
+
+// A raw pointer type which can be safely shared between threads.
+// All operations are sequentially consistent, as with sync/atomic. Its zero value holds nil, and it must not be copied after first use.
+//
+//	value := gost.I32(1)
+//	ptr := gost.AtomicPtrNew(&value)
+//	gost.AssertEq(*ptr.Load(), gost.I32(1))
+type AtomicPtr[T any] struct {
+	value atomic.Pointer[T]
+}
+
+// Creates a new atomic pointer holding ptr.
+func AtomicPtrNew[T any](ptr *T) *AtomicPtr[T] {
+	result := &AtomicPtr[T]{}
+	result.Store(ptr)
+	return result
+}
+
+// Loads the pointer.
+func (self *AtomicPtr[T]) Load() *T {
+	return self.value.Load()
+}
+
+// Stores ptr.
+func (self *AtomicPtr[T]) Store(ptr *T) {
+	self.value.Store(ptr)
+}
+
+// Stores ptr, returning the previous pointer.
+func (self *AtomicPtr[T]) Swap(ptr *T) *T {
+	return self.value.Swap(ptr)
+}
+
+// Stores new if the pointer is current.
+// Returns Ok with the previous pointer, which is current, or an Err of CompareExchangeError with the pointer that was found instead.
+func (self *AtomicPtr[T]) CompareExchange(current *T, new *T) Result[*T] {
+	for {
+		actual := self.Load()
+
+		if actual != current {
+			return Err[*T](CompareExchangeError[*T]{actual: actual})
+		}
+
+		if self.value.CompareAndSwap(current, new) {
+			return Ok(current)
+		}
+	}
+}
+
+// Stores the result of f applied to the pointer, retrying if another thread changes the pointer in between, so f may be called several times.
+// Returns Ok with the previous pointer, or an Err of CompareExchangeError with the pointer if f returned None.
+func (self *AtomicPtr[T]) FetchUpdate(f func(*T) Option[*T]) Result[*T] {
+	for {
+		previous := self.Load()
+		next := f(previous)
+
+		if next.IsNone() {
+			return Err[*T](CompareExchangeError[*T]{actual: previous})
+		}
+
+		if self.value.CompareAndSwap(previous, next.Unwrap()) {
+			return Ok(previous)
+		}
+	}
+}
+
+// impl Display for AtomicPtr
+func (self *AtomicPtr[T]) Display() String {
+	return String(fmt.Sprintf("%p", self.Load()))
+}
+
+// impl Debug for AtomicPtr
+func (self *AtomicPtr[T]) Debug() String {
+	return String(fmt.Sprintf("AtomicPtr(%p)", self.Load()))
+}
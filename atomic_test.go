@@ -0,0 +1,92 @@
+package gost
+
+import "testing"
+
+func Test_AtomicInteger(t *testing.T) {
+	t.Parallel()
+
+	counter := AtomicI64New(0)
+	Scope(func(scope *ThreadScope) {
+		for i := 0; i < 8; i++ {
+			scope.Spawn(func() {
+				for j := 0; j < 1000; j++ {
+					counter.FetchAdd(1)
+				}
+			})
+		}
+	})
+	AssertEq(counter.Load(), I64(8000), "FetchAdd")
+
+	value := AtomicI32New(5)
+	AssertEq(value.Swap(6), I32(5), "Swap")
+	AssertEq(value.CompareExchange(6, 10), Ok[I32](6), "CompareExchange")
+
+	failed := value.CompareExchange(6, 12)
+	Assert(failed.IsErr(), "CompareExchange with a stale value")
+	AssertEq(failed.UnwrapErr().(CompareExchangeError[I32]).Actual(), I32(10), "CompareExchangeError carries the actual value")
+
+	AssertEq(value.FetchSub(3), I32(10), "FetchSub")
+	AssertEq(value.FetchMax(9), I32(7), "FetchMax")
+	AssertEq(value.FetchMin(-1), I32(9), "FetchMin")
+	AssertEq(value.Load(), I32(-1), "FetchMin")
+
+	bits := AtomicU32New(0b1100)
+	AssertEq(bits.FetchAnd(0b1010), U32(0b1100), "FetchAnd")
+	AssertEq(bits.FetchOr(0b0001), U32(0b1000), "FetchOr")
+	AssertEq(bits.FetchXor(0b1111), U32(0b1001), "FetchXor")
+	AssertEq(bits.Load(), U32(0b0110), "FetchXor")
+
+	wrapping := AtomicU64New(0)
+	AssertEq(wrapping.FetchSub(1), U64(0), "FetchSub")
+	AssertEq(wrapping.Load(), U64_MAX, "FetchSub wraps around")
+
+	size := AtomicUSizeNew(7)
+	AssertEq(size.FetchUpdate(func(x USize) Option[USize] { return Some(x * 2) }), Ok[USize](7), "FetchUpdate")
+	AssertEq(size.Load(), USize(14), "FetchUpdate")
+
+	rejected := size.FetchUpdate(func(x USize) Option[USize] { return None[USize]() })
+	AssertEq(rejected.UnwrapErr().(CompareExchangeError[USize]).Actual(), USize(14), "FetchUpdate returning None")
+
+	AssertEq(size.Display(), String("14"), "Display")
+	AssertEq(size.Debug(), String("AtomicUSize(14)"), "Debug")
+
+	var zero AtomicI32
+	AssertEq(zero.Load(), I32(0), "the zero value holds 0")
+}
+
+func Test_AtomicBool(t *testing.T) {
+	t.Parallel()
+
+	flag := AtomicBoolNew(false)
+	AssertEq(flag.Swap(true), Bool(false), "Swap")
+	AssertEq(flag.CompareExchange(true, false), Ok[Bool](true), "CompareExchange")
+	AssertEq(flag.CompareExchange(true, false).UnwrapErr().(CompareExchangeError[Bool]).Actual(), Bool(false), "CompareExchange with a stale value")
+
+	AssertEq(flag.FetchOr(true), Bool(false), "FetchOr")
+	AssertEq(flag.FetchAnd(false), Bool(true), "FetchAnd")
+	AssertEq(flag.FetchXor(true), Bool(false), "FetchXor")
+	AssertEq(flag.FetchNand(true), Bool(true), "FetchNand")
+	AssertEq(flag.Load(), Bool(false), "FetchNand")
+
+	AssertEq(flag.FetchUpdate(func(x Bool) Option[Bool] { return Some(!x) }), Ok[Bool](false), "FetchUpdate")
+	AssertEq(flag.Display(), String("true"), "Display")
+	AssertEq(flag.Debug(), String("AtomicBool(true)"), "Debug")
+}
+
+func Test_AtomicPtr(t *testing.T) {
+	t.Parallel()
+
+	first, second := I32(1), I32(2)
+	ptr := AtomicPtrNew(&first)
+	AssertEq(*ptr.Load(), I32(1), "Load")
+	Assert(Bool(ptr.Swap(&second) == &first), "Swap")
+	Assert(ptr.CompareExchange(&first, &second).IsErr(), "CompareExchange with a stale pointer")
+	Assert(Bool(ptr.CompareExchange(&second, nil).Unwrap() == &second), "CompareExchange")
+
+	var zero AtomicPtr[I32]
+	Assert(Bool(zero.Load() == nil), "the zero value holds nil")
+	AssertEq(zero.Debug(), String("AtomicPtr(0x0)"), "Debug")
+
+	updated := ptr.FetchUpdate(func(current *I32) Option[*I32] { return Some(&first) })
+	Assert(Bool(updated.Unwrap() == nil && ptr.Load() == &first), "FetchUpdate")
+}
@@ -246,6 +246,34 @@ func (self *BinaryHeap[T]) IntoSortedVec() Vec[T] {
 	return sortedVec
 }
 
+// Extends a collection with the contents of an iterator.
+//
+//	heap := gost.BinaryHeapNew[gost.I32]()
+//	vec := gost.VecNew[gost.I32]()
+//	vec.Push(1)
+//	vec.Push(3)
+//	heap.Extend(vec)
+//	gost.AssertEq(heap.Pop(), gost.Some[gost.I32](3))
+func (self *BinaryHeap[T]) Extend(iter IntoIterator[T]) {
+	iterator := iter.IntoIter()
+
+	for {
+		value := iterator.Next()
+
+		if value.IsNone() {
+			return
+		}
+
+		self.Push(value.Unwrap())
+	}
+}
+
+// impl FromIterator for BinaryHeap
+func (self *BinaryHeap[T]) FromIter(iter Iterator[T]) {
+	*self = BinaryHeapNew[T]()
+	self.Extend(iter)
+}
+
 /// Hole represents a hole in a slice i.e., an index without valid value
 /// (because it was moved from or duplicated).
 /// In drop, `Hole` will restore the slice by filling the hole
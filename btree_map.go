@@ -222,7 +222,7 @@ func (self BTreeNode[K, V]) _Traverse() {
 				self.childs.GetUnchecked(i)._Traverse()
 			}
 		}
-		Println("{}, {}", self.keys.GetUnchecked(i), self.values.GetUnchecked(i))
+		Println("{}, {}", *self.keys.GetUnchecked(i), *self.values.GetUnchecked(i))
 		i++
 	}
 }
@@ -755,8 +755,10 @@ func (self *BTreeNode[K, V]) _Merge(idx int) {
 }
 
 type BTreeMapIter[K Ord[K], V any] struct {
+	_IterBase[Pair[K, V]]
 	vec      Vec[Pair[K, V]]
 	position USize
+	end      USize
 }
 
 // into_iter
@@ -767,12 +769,14 @@ func (self BTreeMap[K, V]) IntoIter() Iterator[Pair[K, V]] {
 		vec = self.root._ToVec()
 	}
 
-	return &BTreeMapIter[K, V]{vec: vec, position: 0}
+	iter := &BTreeMapIter[K, V]{vec: vec, position: 0, end: vec.Len()}
+	iter._IterBase = _IterBase[Pair[K, V]]{iter}
+	return iter
 }
 
 // next
 func (self *BTreeMapIter[K, V]) Next() Option[Pair[K, V]] {
-	if self.position >= self.vec.Len() {
+	if self.position >= self.end {
 		return None[Pair[K, V]]()
 	}
 
@@ -781,89 +785,23 @@ func (self *BTreeMapIter[K, V]) Next() Option[Pair[K, V]] {
 	return Some(result)
 }
 
-// map
-func (self *BTreeMapIter[K, V]) Map(f func(Pair[K, V]) Pair[K, V]) Iterator[Pair[K, V]] {
-	newVec := VecNew[Pair[K, V]]()
-
-	for {
-		value := self.Next()
-
-		if value.IsNone() {
-			return newVec.IntoIter()
-		}
-		newVec.Push(f(value.Unwrap()))
-	}
-}
-
-// filter
-func (self *BTreeMapIter[K, V]) Filter(f func(Pair[K, V]) Bool) Iterator[Pair[K, V]] {
-	newVec := VecNew[Pair[K, V]]()
-
-	for {
-		value := self.Next()
-
-		if value.IsNone() {
-			return newVec.IntoIter()
-		}
-
-		if f(value.Unwrap()) {
-			newVec.Push(value.Unwrap())
-		}
-	}
-}
-
-// fold
-func (self *BTreeMapIter[K, V]) Fold(init Pair[K, V], f func(Pair[K, V], Pair[K, V]) Pair[K, V]) Pair[K, V] {
-	for {
-		value := self.Next()
-
-		if value.IsNone() {
-			return init
-		}
-
-		init = f(init, value.Unwrap())
-	}
-}
-
-// rev
-func (self BTreeMapIter[K, V]) Rev() Iterator[Pair[K, V]] {
-	newVec := VecWithLen[Pair[K, V]](self.vec.Len())
-	i := self.vec.Len() - 1
-
-	for {
-		value := self.Next()
-
-		if value.IsNone() {
-			return newVec.IntoIter()
-		}
-		newVec.AsSlice()[i] = value.Unwrap()
-		i--
+// next_back
+func (self *BTreeMapIter[K, V]) NextBack() Option[Pair[K, V]] {
+	if self.position >= self.end {
+		return None[Pair[K, V]]()
 	}
-}
-
-// Collect to Vec
-func (self BTreeMapIter[K, V]) CollectToVec() Vec[Pair[K, V]] {
-	return self.vec
-}
-
-// Collect to LinkedList
-func (self BTreeMapIter[K, V]) CollectToLinkedList() LinkedList[Pair[K, V]] {
-	list := LinkedListNew[Pair[K, V]]()
 
-	for {
-		value := self.Next()
+	self.end--
 
-		if value.IsNone() {
-			return list
-		}
-		list.PushBack(value.Unwrap())
-	}
+	return Some[Pair[K, V]](self.vec.GetUnchecked(self.end))
 }
 
 // An iterator visiting all keys in arbitrary order. The iterator element type is K.
 type BTreeMapKeys[K any] struct {
+	_IterBase[K]
 	vec      Vec[K]
 	position USize
+	end      USize
 }
 
 // An iterator visiting all keys in arbitrary order. The iterator element type is K.
@@ -881,12 +819,14 @@ func (self BTreeMap[K, V]) Keys() Iterator[K] {
 		vec = self.root._ToKeyVec()
 	}
 
-	return &BTreeMapKeys[K]{vec: vec, position: 0}
+	iter := &BTreeMapKeys[K]{vec: vec, position: 0, end: vec.Len()}
+	iter._IterBase = _IterBase[K]{iter}
+	return iter
 }
 
 // next
 func (self *BTreeMapKeys[K]) Next() Option[K] {
-	if self.position >= self.vec.Len() {
+	if self.position >= self.end {
 		return None[K]()
 	}
 
@@ -895,89 +835,23 @@ func (self *BTreeMapKeys[K]) Next() Option[K] {
 	return Some(result)
 }
 
-// map
-func (self *BTreeMapKeys[K]) Map(f func(K) K) Iterator[K] {
-	newVec := VecNew[K]()
-
-	for {
-		value := self.Next()
-
-		if value.IsNone() {
-			return newVec.IntoIter()
-		}
-		newVec.Push(f(value.Unwrap()))
-	}
-}
-
-// filter
-func (self *BTreeMapKeys[K]) Filter(f func(K) Bool) Iterator[K] {
-	newVec := VecNew[K]()
-
-	for {
-		value := self.Next()
-
-		if value.IsNone() {
-			return newVec.IntoIter()
-		}
-
-		if f(value.Unwrap()) {
-			newVec.Push(value.Unwrap())
-		}
-	}
-}
-
-// fold
-func (self *BTreeMapKeys[K]) Fold(init K, f func(K, K) K) K {
-	for {
-		value := self.Next()
-
-		if value.IsNone() {
-			return init
-		}
-
-		init = f(init, value.Unwrap())
-	}
-}
-
-// rev
-func (self BTreeMapKeys[K]) Rev() Iterator[K] {
-	newVec := VecWithLen[K](self.vec.Len())
-	i := self.vec.Len() - 1
-
-	for {
-		value := self.Next()
-
-		if value.IsNone() {
-			return newVec.IntoIter()
-		}
-		newVec.AsSlice()[i] = value.Unwrap()
-		i--
+// next_back
+func (self *BTreeMapKeys[K]) NextBack() Option[K] {
+	if self.position >= self.end {
+		return None[K]()
 	}
-}
-
-// Collect to Vec
-func (self BTreeMapKeys[K]) CollectToVec() Vec[K] {
-	return self.vec
-}
 
-// Collect to LinkedList
-func (self BTreeMapKeys[K]) CollectToLinkedList() LinkedList[K] {
-	list := LinkedListNew[K]()
+	self.end--
 
-	for {
-		value := self.Next()
-
-		if value.IsNone() {
-			return list
-		}
-		list.PushBack(value.Unwrap())
-	}
+	return Some[K](self.vec.GetUnchecked(self.end))
 }
 
 // An iterator visiting all values in arbitrary order. The iterator element type is V.
 type BTreeMapValues[V any] struct {
+	_IterBase[V]
 	vec      Vec[V]
 	position USize
+	end      USize
 }
 
 // An iterator visiting all values in arbitrary order. The iterator element type is V.
@@ -995,12 +869,14 @@ func (self BTreeMap[K, V]) Values() Iterator[V] {
 		vec = self.root._ToValueVec()
 	}
 
-	return &BTreeMapValues[V]{vec: vec, position: 0}
+	iter := &BTreeMapValues[V]{vec: vec, position: 0, end: vec.Len()}
+	iter._IterBase = _IterBase[V]{iter}
+	return iter
 }
 
 // next
 func (self *BTreeMapValues[V]) Next() Option[V] {
-	if self.position >= self.vec.Len() {
+	if self.position >= self.end {
 		return None[V]()
 	}
 
@@ -1009,83 +885,43 @@ func (self *BTreeMapValues[V]) Next() Option[V] {
 	return Some(result)
 }
 
-// map
-func (self *BTreeMapValues[V]) Map(f func(V) V) Iterator[V] {
-	newVec := VecNew[V]()
-
-	for {
-		value := self.Next()
-
-		if value.IsNone() {
-			return newVec.IntoIter()
-		}
-		newVec.Push(f(value.Unwrap()))
-	}
-}
-
-// filter
-func (self *BTreeMapValues[V]) Filter(f func(V) Bool) Iterator[V] {
-	newVec := VecNew[V]()
-
-	for {
-		value := self.Next()
-
-		if value.IsNone() {
-			return newVec.IntoIter()
-		}
-
-		if f(value.Unwrap()) {
-			newVec.Push(value.Unwrap())
-		}
+// next_back
+func (self *BTreeMapValues[V]) NextBack() Option[V] {
+	if self.position >= self.end {
+		return None[V]()
 	}
-}
-
-// fold
-func (self *BTreeMapValues[V]) Fold(init V, f func(V, V) V) V {
-	for {
-		value := self.Next()
 
-		if value.IsNone() {
-			return init
-		}
+	self.end--
 
-		init = f(init, value.Unwrap())
-	}
+	return Some[V](self.vec.GetUnchecked(self.end))
 }
 
-// rev
-func (self BTreeMapValues[V]) Rev() Iterator[V] {
-	newVec := VecWithLen[V](self.vec.Len())
-	i := self.vec.Len() - 1
+// Extends a collection with the contents of an iterator.
+//
+//	treeMap := gost.BTreeMapNew[gost.String, gost.I32]()
+//	pairs := gost.VecNew[gost.Pair[gost.String, gost.I32]]()
+//	pairs.Push(gost.Pair[gost.String, gost.I32]{Key: "a", Value: 1})
+//	treeMap.Extend(pairs)
+//	gost.AssertEq(treeMap.Get("a"), gost.Some[gost.I32](1))
+func (self *BTreeMap[K, V]) Extend(iter IntoIterator[Pair[K, V]]) {
+	iterator := iter.IntoIter()
 
 	for {
-		value := self.Next()
+		value := iterator.Next()
 
 		if value.IsNone() {
-			return newVec.IntoIter()
+			return
 		}
-		newVec.AsSlice()[i] = value.Unwrap()
-		i--
-	}
-}
 
-// Collect to Vec
-func (self BTreeMapValues[V]) CollectToVec() Vec[V] {
-	return self.vec
+		pair := value.Unwrap()
+		self.Insert(pair.Key, pair.Value)
+	}
 }
 
-// Collect to LinkedList
-func (self BTreeMapValues[V]) CollectToLinkedList() LinkedList[V] {
-	list := LinkedListNew[V]()
-
-	for {
-		value := self.Next()
-
-		if value.IsNone() {
-			return list
-		}
-		list.PushBack(value.Unwrap())
-	}
+// impl FromIterator for BTreeMap
+func (self *BTreeMap[K, V]) FromIter(iter Iterator[Pair[K, V]]) {
+	*self = BTreeMapNew[K, V]()
+	self.Extend(iter)
 }
 
 // impl Display for BTreeMap
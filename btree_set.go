@@ -299,20 +299,24 @@ func (self BTreeSet[K]) SymmetricDifference(other BTreeSet[K]) BTreeSet[K] {
 
 // Returns an iterator over the set.
 type BTreeSetIter[K Ord[K]] struct {
+	_IterBase[K]
 	vec      Vec[K]
 	position USize
+	end      USize
 }
 
 // into_iter
 func (self *BTreeSet[K]) IntoIter() Iterator[K] {
 	keys := self._treemap.root._ToKeyVec()
 
-	return &BTreeSetIter[K]{vec: keys, position: 0}
+	iter := &BTreeSetIter[K]{vec: keys, position: 0, end: keys.Len()}
+	iter._IterBase = _IterBase[K]{iter}
+	return iter
 }
 
 // next
 func (self *BTreeSetIter[K]) Next() Option[K] {
-	if self.position >= self.vec.Len() {
+	if self.position >= self.end {
 		return None[K]()
 	}
 
@@ -322,83 +326,43 @@ func (self *BTreeSetIter[K]) Next() Option[K] {
 	return Some[K](value)
 }
 
-// map
-func (self BTreeSetIter[K]) Map(f func(K) K) Iterator[K] {
-	newVec := VecNew[K]()
-
-	for {
-		value := self.Next()
-
-		if value.IsNone() {
-			return newVec.IntoIter()
-		}
-		newVec.Push(f(value.Unwrap()))
+// next_back
+func (self *BTreeSetIter[K]) NextBack() Option[K] {
+	if self.position >= self.end {
+		return None[K]()
 	}
-}
 
-// filter
-func (self BTreeSetIter[K]) Filter(f func(K) Bool) Iterator[K] {
-	newVec := VecNew[K]()
-
-	for {
-		value := self.Next()
+	self.end--
 
-		if value.IsNone() {
-			return newVec.IntoIter()
-		}
-
-		if f(value.Unwrap()) {
-			newVec.Push(value.Unwrap())
-		}
-	}
+	return Some[K](self.vec.GetUnchecked(self.end))
 }
 
-// fold
-func (self BTreeSetIter[K]) Fold(init K, f func(K, K) K) K {
-	for {
-		value := self.Next()
-
-		if value.IsNone() {
-			return init
-		}
-
-		init = f(init, value.Unwrap())
-	}
-}
-
-// rev
-func (self BTreeSetIter[K]) Rev() Iterator[K] {
-	newVec := VecWithLen[K](self.vec.Len())
-	i := self.vec.Len() - 1
+// Extends a collection with the contents of an iterator.
+//
+//	set := gost.BTreeSetNew[gost.I32]()
+//	vec := gost.VecNew[gost.I32]()
+//	vec.Push(1)
+//	vec.Push(1)
+//	set.Extend(vec)
+//	gost.AssertEq(set.Len(), gost.USize(1))
+func (self *BTreeSet[K]) Extend(iter IntoIterator[K]) {
+	iterator := iter.IntoIter()
 
 	for {
-		value := self.Next()
+		value := iterator.Next()
 
 		if value.IsNone() {
-			return newVec.IntoIter()
+			return
 		}
-		newVec.AsSlice()[i] = value.Unwrap()
-		i--
-	}
-}
 
-// Collect to Vec
-func (self BTreeSetIter[K]) CollectToVec() Vec[K] {
-	return self.vec
+		self.Insert(value.Unwrap())
+	}
 }
 
-// Collect to LinkedList
-func (self BTreeSetIter[K]) CollectToLinkedList() LinkedList[K] {
-	list := LinkedListNew[K]()
-
-	for {
-		value := self.Next()
-
-		if value.IsNone() {
-			return list
-		}
-		list.PushBack(value.Unwrap())
-	}
+// impl FromIterator for BTreeSet
+func (self *BTreeSet[K]) FromIter(iter Iterator[K]) {
+	*self = BTreeSetNew[K]()
+	self.Extend(iter)
 }
 
 // impl Display for BTreeSet
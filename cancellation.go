@@ -0,0 +1,170 @@
+package gost
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// A token to signal cancellation to any number of threads or tasks.
+// Cancelling a token cancels all of its child tokens, but cancelling a child does not cancel its parent.
+// A CancellationToken is a context.Context, whose Done channel is closed and whose Err is context.Canceled once the token is cancelled.
+//
+//	token := gost.CancellationTokenNew()
+//	child := token.ChildToken()
+//	token.Cancel()
+//	gost.Assert(child.IsCancelled())
+type CancellationToken struct {
+	state *_CancellationState
+}
+
+type _CancellationState struct {
+	lock     sync.Mutex
+	done     chan struct{}
+	parent   *_CancellationState
+	children map[*_CancellationState]struct{}
+}
+
+// Creates a new token, which is not cancelled.
+func CancellationTokenNew() CancellationToken {
+	return CancellationToken{state: &_CancellationState{done: make(chan struct{}), children: map[*_CancellationState]struct{}{}}}
+}
+
+// Creates a token that is cancelled when ctx is done, or when the token itself is cancelled.
+// A goroutine watches ctx until one of them happens, so cancel the token once it is no longer needed, like the cancel function of context.WithCancel; otherwise the goroutine leaks when ctx is never done.
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+//	defer cancel()
+//	token := gost.CancellationTokenFromContext(ctx)
+//	defer token.Cancel()
+func CancellationTokenFromContext(ctx context.Context) CancellationToken {
+	if token, ok := ctx.(CancellationToken); ok {
+		return token.ChildToken()
+	}
+
+	token := CancellationTokenNew()
+
+	if ctx.Done() != nil {
+		go func() {
+			select {
+			case <-ctx.Done():
+				token.Cancel()
+			case <-token.Done():
+			}
+		}()
+	}
+
+	return token
+}
+
+// Creates a token that is cancelled when this token is cancelled. Cancelling the child does not cancel this token.
+// If this token is already cancelled, the child is too.
+func (self CancellationToken) ChildToken() CancellationToken {
+	child := CancellationTokenNew()
+
+	self.state.lock.Lock()
+	defer self.state.lock.Unlock()
+
+	select {
+	case <-self.state.done:
+		close(child.state.done)
+	default:
+		child.state.parent = self.state
+		self.state.children[child.state] = struct{}{}
+	}
+
+	return child
+}
+
+// Cancels the token and all of its child tokens. Cancelling a token twice does nothing.
+func (self CancellationToken) Cancel() {
+	self.state.cancel()
+
+	// A cancelled child no longer needs to be cancelled with its parent.
+	if parent := self.state.parent; parent != nil {
+		parent.lock.Lock()
+		delete(parent.children, self.state)
+		parent.lock.Unlock()
+	}
+}
+
+func (self *_CancellationState) cancel() {
+	self.lock.Lock()
+
+	select {
+	case <-self.done:
+		self.lock.Unlock()
+		return
+	default:
+	}
+
+	close(self.done)
+	children := self.children
+	self.children = map[*_CancellationState]struct{}{}
+	self.lock.Unlock()
+
+	for child := range children {
+		child.cancel()
+	}
+}
+
+// Checks if the token has been cancelled.
+func (self CancellationToken) IsCancelled() Bool {
+	select {
+	case <-self.state.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// Blocks the current thread until the token is cancelled.
+func (self CancellationToken) WaitCancelled() {
+	<-self.state.done
+}
+
+// impl context.Context for CancellationToken
+// Returns a channel that is closed once the token is cancelled.
+func (self CancellationToken) Done() <-chan struct{} {
+	return self.state.done
+}
+
+// impl context.Context for CancellationToken
+// Returns context.Canceled once the token is cancelled, or nil before.
+func (self CancellationToken) Err() error {
+	if self.IsCancelled() {
+		return context.Canceled
+	}
+
+	return nil
+}
+
+// impl context.Context for CancellationToken
+// A token has no deadline.
+func (self CancellationToken) Deadline() (time.Time, bool) {
+	return time.Time{}, false
+}
+
+// impl context.Context for CancellationToken
+// A token carries no values.
+func (self CancellationToken) Value(key any) any {
+	return nil
+}
+
+// Puts the current thread to sleep for at least the specified amount of time, or until token is cancelled.
+// Returns an Err of context.Canceled if the token was cancelled first.
+//
+//	if gost.SleepCancellable(gost.DurationFromSecs(5), token).IsErr() {
+//		return
+//	}
+func SleepCancellable(dur Duration, token CancellationToken) Result[any] {
+	timer := time.NewTimer(dur.ToGoDuration())
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return Ok[any](nil)
+	case <-token.Done():
+		return Err[any](context.Canceled)
+	}
+}
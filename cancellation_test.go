@@ -0,0 +1,51 @@
+package gost
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func Test_CancellationToken(t *testing.T) {
+	t.Parallel()
+
+	token := CancellationTokenNew()
+	child := token.ChildToken()
+	grandchild := child.ChildToken()
+	Assert(!token.IsCancelled(), "a new token is not cancelled")
+
+	child.Cancel()
+	Assert(child.IsCancelled() && grandchild.IsCancelled(), "cancelling a token cancels its children")
+	Assert(!token.IsCancelled(), "cancelling a child does not cancel its parent")
+
+	other := token.ChildToken()
+	token.Cancel()
+	token.Cancel()
+	Assert(other.IsCancelled(), "Cancel")
+	Assert(token.ChildToken().IsCancelled(), "the child of a cancelled token is cancelled")
+	Assert(Bool(errors.Is(token.Err(), context.Canceled)), "Err")
+	Assert(SleepCancellable(DurationFromSecs(10), token).IsErr(), "SleepCancellable")
+	Assert(SleepCancellable(DurationFromMillis(1), CancellationTokenNew()).IsOk(), "SleepCancellable")
+}
+
+func Test_CancellationToken_Context(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	token := CancellationTokenFromContext(ctx)
+	cancel()
+
+	select {
+	case <-token.Done():
+	case <-time.After(time.Second):
+		Panic("the token is cancelled with its context")
+	}
+
+	parent := CancellationTokenNew()
+	derived, stop := context.WithCancel(parent)
+	defer stop()
+	parent.Cancel()
+	<-derived.Done()
+	Assert(Bool(errors.Is(derived.Err(), context.Canceled)), "a token is a context")
+}
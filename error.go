@@ -0,0 +1,222 @@
+package gost
+
+import (
+	"errors"
+	"os"
+	"runtime"
+	"strings"
+	"sync/atomic"
+)
+
+var _backtraceEnabled atomic.Bool
+
+func init() {
+	value := os.Getenv("GOST_BACKTRACE")
+	_backtraceEnabled.Store(value != "" && value != "0")
+}
+
+// Enables or disables capturing a Backtrace whenever an Err is constructed.
+// Capturing is disabled by default, and can also be enabled by setting the GOST_BACKTRACE environment variable to a value other than "0".
+//
+//	gost.SetBacktraceEnabled(true)
+//	result := gost.Err[gost.I32](errors.New("error"))
+//	gost.Assert(result.Backtrace().IsSome())
+func SetBacktraceEnabled(enabled Bool) {
+	_backtraceEnabled.Store(bool(enabled))
+}
+
+// Returns true if a Backtrace is captured whenever an Err is constructed.
+func BacktraceEnabled() Bool {
+	return Bool(_backtraceEnabled.Load())
+}
+
+// A captured stack backtrace.
+type Backtrace struct {
+	frames []runtime.Frame
+}
+
+// Captures a stack backtrace of the caller, regardless of whether backtraces are enabled.
+//
+//	backtrace := gost.BacktraceForceCapture()
+//	gost.Println("{}", backtrace)
+func BacktraceForceCapture() Backtrace {
+	return _CaptureBacktrace(3)
+}
+
+// skip is the number of stack frames to drop, counted from the caller of runtime.Callers.
+func _CaptureBacktrace(skip int) Backtrace {
+	pcs := make([]uintptr, 64)
+	n := runtime.Callers(skip, pcs)
+
+	frames := runtime.CallersFrames(pcs[:n])
+	backtrace := Backtrace{}
+
+	for {
+		frame, more := frames.Next()
+		backtrace.frames = append(backtrace.frames, frame)
+
+		if !more {
+			return backtrace
+		}
+	}
+}
+
+// impl Display for Backtrace
+func (self Backtrace) Display() String {
+	builder := strings.Builder{}
+
+	for i, frame := range self.frames {
+		if i > 0 {
+			builder.WriteString("\n")
+		}
+		builder.WriteString(string(Format("{}: {}\n             at {}:{}", I32(i), String(frame.Function), String(frame.File), I32(frame.Line))))
+	}
+
+	return String(builder.String())
+}
+
+// impl Debug for Backtrace
+func (self Backtrace) Debug() String {
+	return self.Display()
+}
+
+// Implemented by errors that carry a Backtrace.
+type _BacktraceProvider interface {
+	_Backtrace() Option[Backtrace]
+}
+
+// An error wrapped with additional context, created by Result.Context and Result.WithContext.
+// ContextError supports errors.Is and errors.As through Unwrap.
+type ContextError struct {
+	context   String
+	source    error
+	backtrace Option[Backtrace]
+}
+
+// Wraps an error with a context message.
+//
+//	err := gost.ErrorContext(io.EOF, "failed to read config")
+//	gost.Assert(errors.Is(err, io.EOF))
+func ErrorContext(err error, context String) error {
+	return ContextError{context: context, source: err, backtrace: _ErrorBacktrace(err)}
+}
+
+// Returns the context message of this error, without its source.
+func (self ContextError) Context() String {
+	return self.context
+}
+
+// Returns the error wrapped by this context.
+func (self ContextError) Source() error {
+	return self.source
+}
+
+// impl error for ContextError
+func (self ContextError) Error() string {
+	return string(self.context) + ": " + self.source.Error()
+}
+
+// Unwrap returns the wrapped error, for errors.Is and errors.As.
+func (self ContextError) Unwrap() error {
+	return self.source
+}
+
+func (self ContextError) _Backtrace() Option[Backtrace] {
+	return self.backtrace
+}
+
+// impl Display for ContextError
+func (self ContextError) Display() String {
+	return String(self.Error())
+}
+
+// impl Debug for ContextError
+func (self ContextError) Debug() String {
+	return ErrorReport(self)
+}
+
+// Returns the chain of errors, starting with err and following Unwrap down to the root cause.
+//
+//	err := gost.ErrorContext(io.EOF, "failed to read config")
+//	chain := gost.ErrorChain(err)
+//	gost.AssertEq(chain.Len(), gost.USize(2))
+func ErrorChain(err error) Vec[error] {
+	chain := VecNew[error]()
+
+	for err != nil {
+		chain.Push(err)
+		err = errors.Unwrap(err)
+	}
+
+	return chain
+}
+
+// Returns the lowest level cause of err, which is the last error of its chain.
+//
+//	err := gost.ErrorContext(io.EOF, "failed to read config")
+//	gost.Assert(gost.ErrorRootCause(err) == io.EOF)
+func ErrorRootCause(err error) error {
+	for {
+		source := errors.Unwrap(err)
+
+		if source == nil {
+			return err
+		}
+		err = source
+	}
+}
+
+// Formats err with its full cause chain, one error per line, followed by its backtrace if one was captured.
+// Returns an empty string for a nil error.
+//
+//	failed to read config
+//
+//	Caused by:
+//	    0: EOF
+func ErrorReport(err error) String {
+	if err == nil {
+		return ""
+	}
+
+	builder := strings.Builder{}
+	builder.WriteString(_ErrorMessage(err))
+
+	chain := ErrorChain(err).AsSlice()
+	if len(chain) > 1 {
+		builder.WriteString("\n\nCaused by:")
+
+		for i, cause := range chain[1:] {
+			builder.WriteString(string(Format("\n    {}: {}", I32(i), String(_ErrorMessage(cause)))))
+		}
+	}
+
+	backtrace := _ErrorBacktrace(err)
+	if backtrace.IsSome() {
+		builder.WriteString("\n\nStack backtrace:\n")
+		builder.WriteString(string(backtrace.Unwrap().Display()))
+	}
+
+	return String(builder.String())
+}
+
+// The message of a single link of an error chain, without the messages of its sources.
+func _ErrorMessage(err error) string {
+	if contextError, ok := err.(ContextError); ok {
+		return string(contextError.context)
+	}
+
+	return err.Error()
+}
+
+func _ErrorBacktrace(err error) Option[Backtrace] {
+	for err != nil {
+		if provider, ok := err.(_BacktraceProvider); ok {
+			if backtrace := provider._Backtrace(); backtrace.IsSome() {
+				return backtrace
+			}
+		}
+		err = errors.Unwrap(err)
+	}
+
+	return None[Backtrace]()
+}
@@ -0,0 +1,57 @@
+package gost
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"strings"
+	"testing"
+)
+
+func Test_Result_Context(t *testing.T) {
+	t.Parallel()
+
+	result := Err[I32](io.EOF).Context("failed to read header").WithContext(func() String { return "failed to load config" })
+	err := result.UnwrapErr()
+
+	Assert(Bool(errors.Is(err, io.EOF)), "errors.Is finds the root cause")
+	AssertEq(String(err.Error()), String("failed to load config: failed to read header: EOF"), "Error contains the chain")
+	AssertEq(ErrorChain(err).Len(), USize(3), "ErrorChain")
+	Assert(ErrorRootCause(err) == io.EOF, "ErrorRootCause")
+
+	var contextError ContextError
+	Assert(Bool(errors.As(err, &contextError)), "errors.As finds the ContextError")
+	AssertEq(contextError.Context(), String("failed to load config"), "ContextError.Context")
+
+	AssertEq(Ok[I32](1).Context("unused"), Ok[I32](1), "Context leaves Ok untouched")
+	AssertEq(Ok[I32](1).WithContext(func() String { panic("must not be evaluated") }), Ok[I32](1), "WithContext is lazy")
+
+	pathError := &fs.PathError{Op: "open", Path: "config", Err: fs.ErrNotExist}
+	var target *fs.PathError
+	Assert(Bool(errors.As(Err[I32](pathError).Context("failed").UnwrapErr(), &target)), "errors.As through a context")
+}
+
+func Test_Result_Debug_Chain(t *testing.T) {
+	t.Parallel()
+
+	report := Err[I32](io.EOF).Context("failed to read header").Context("failed to load config").Debug()
+
+	AssertEq(report, String("failed to load config\n\nCaused by:\n    0: failed to read header\n    1: EOF"), "Debug prints the full chain")
+	AssertEq(ErrorReport(io.EOF), String("EOF"), "ErrorReport of an error without a source")
+	AssertEq(ErrorReport(nil), String(""), "ErrorReport of nil")
+}
+
+func Test_Result_Backtrace(t *testing.T) {
+	Assert(Err[I32](io.EOF).Backtrace().IsNone(), "backtraces are disabled by default")
+
+	SetBacktraceEnabled(true)
+	defer SetBacktraceEnabled(false)
+
+	result := Err[I32](io.EOF)
+	backtrace := result.Backtrace()
+	Assert(backtrace.IsSome(), "backtrace is captured at Err")
+	Assert(Bool(strings.Contains(string(backtrace.Unwrap().Display()), "Test_Result_Backtrace")), "backtrace starts at the caller")
+
+	contextual := ResultMap(result.Context("failed"), func(value I32) String { return value.ToString() })
+	Assert(Bool(strings.Contains(string(contextual.Debug()), "Stack backtrace:")), "backtrace survives Context and ResultMap")
+}
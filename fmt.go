@@ -2,7 +2,14 @@ package gost
 
 import (
 	"fmt"
+	"math/big"
 	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/myyrakle/gost/internal/formatspec"
 )
 
 // Format trait for an empty format, {}
@@ -103,7 +110,7 @@ func castToDisplay[T any](value T) Option[Display[T]] {
 // ? formatting.
 // Debug should format the output in a programmer-facing, debugging context.
 type Debug[T any] interface {
-	Debug() string
+	Debug() String
 }
 
 func (self ISize) Debug() String {
@@ -185,3 +192,421 @@ func (self Complex64) Debug() String {
 func (self Complex128) Debug() String {
 	return String(fmt.Sprintf("Complex128(%s)", self.ToString()))
 }
+
+// An error returned by TryFormat when a format string is malformed or does not match its arguments.
+type FormatError struct {
+	message string
+}
+
+// impl error for FormatError
+func (self FormatError) Error() string {
+	return "invalid format string: " + self.message
+}
+
+// Creates a String using interpolation of runtime expressions, following the syntax of Rust's format! macro.
+// Panics with a descriptive message if the format string is malformed or does not match its arguments; use TryFormat to handle that as an error.
+//
+//	{}           next argument, formatted with Display
+//	{0}, {name}  positional argument, or a named one looked up in map and struct arguments
+//	{:?}         formatted with Debug
+//	{:>8} {:*^8} {:<8}  width with optional fill and alignment; numbers are right-aligned by default
+//	{:08.3} {:+}        zero padding, precision and sign
+//	{:x} {:#X} {:b} {:o} {:e}  hexadecimal, binary, octal and exponent forms; # adds the 0x/0b/0o prefix
+//	{:1$} {:.*}  width or precision taken from an argument
+//	{{ }}        literal braces
+//
+//	gost.AssertEq(gost.Format("{0}-{0} {:>5}", gost.I32(1), gost.String("ab")), gost.String("1-1    ab"))
+//	gost.AssertEq(gost.Format("{:#06x}", gost.I32(255)), gost.String("0x00ff"))
+func Format(format String, params ...any) String {
+	result := TryFormat(format, params...)
+
+	if result.IsErr() {
+		panic(result.UnwrapErr().Error())
+	}
+
+	return result.Unwrap()
+}
+
+// Creates a String like Format, but returns a FormatError instead of panicking when the format string is malformed,
+// when a placeholder refers to a missing argument, or when an argument is never used.
+//
+//	gost.Assert(gost.TryFormat("{} {}", gost.I32(1)).IsErr())
+func TryFormat(format String, params ...any) Result[String] {
+	pieces, err := formatspec.Parse(string(format))
+	if err != nil {
+		return Err[String](FormatError{message: err.Error()})
+	}
+
+	formatter := _Formatter{params: params, used: make([]bool, len(params))}
+	builder := strings.Builder{}
+
+	for _, piece := range pieces {
+		if piece.Placeholder == nil {
+			builder.WriteString(piece.Literal)
+			continue
+		}
+
+		text, err := formatter.placeholder(*piece.Placeholder)
+		if err != nil {
+			return Err[String](err)
+		}
+
+		builder.WriteString(text)
+	}
+
+	for index, used := range formatter.used {
+		if !used {
+			return Err[String](_FormatErrorf("%d arguments were given but argument %d is never used", len(params), index))
+		}
+	}
+
+	return Ok(String(builder.String()))
+}
+
+type _Formatter struct {
+	params []any
+	used   []bool
+}
+
+type _FormatSpec struct {
+	fill      rune
+	align     rune
+	sign      bool
+	alternate bool
+	zero      bool
+	width     int
+	precision int
+	kind      string
+}
+
+func _FormatErrorf(format string, args ...any) error {
+	return FormatError{message: fmt.Sprintf(format, args...)}
+}
+
+func (self *_Formatter) placeholder(placeholder formatspec.Placeholder) (string, error) {
+	width, err := self.count(placeholder.Spec.Width)
+	if err != nil {
+		return "", err
+	}
+
+	precision, err := self.count(placeholder.Spec.Precision)
+	if err != nil {
+		return "", err
+	}
+
+	value, err := self.argument(placeholder.Argument)
+	if err != nil {
+		return "", err
+	}
+
+	spec := _FormatSpec{
+		fill:      placeholder.Spec.Fill,
+		align:     placeholder.Spec.Align,
+		sign:      placeholder.Spec.Sign,
+		alternate: placeholder.Spec.Alternate,
+		zero:      placeholder.Spec.Zero,
+		width:     width,
+		precision: precision,
+		kind:      placeholder.Spec.Kind,
+	}
+
+	return spec.render(value)
+}
+
+// Looks up a positional or named argument and marks it used.
+func (self *_Formatter) argument(argument formatspec.Argument) (any, error) {
+	if argument.Name == "" {
+		return self.positional(argument.Index)
+	}
+
+	for index, param := range self.params {
+		if value, ok := _LookupNamedArgument(param, argument.Name); ok {
+			self.used[index] = true
+			return value, nil
+		}
+	}
+
+	return nil, _FormatErrorf("there is no argument named '%s'; named arguments are looked up in map and struct arguments", argument.Name)
+}
+
+func (self *_Formatter) positional(index int) (any, error) {
+	if index < 0 || index >= len(self.params) {
+		return nil, _FormatErrorf("placeholder refers to argument %d, but %d argument(s) were given", index, len(self.params))
+	}
+
+	self.used[index] = true
+
+	return self.params[index], nil
+}
+
+// Returns the value of a width or precision, or -1 if there is none.
+func (self *_Formatter) count(count formatspec.Count) (int, error) {
+	if count.Argument == nil {
+		return count.Value, nil
+	}
+
+	value, err := self.argument(*count.Argument)
+	if err != nil {
+		return -1, err
+	}
+
+	return _FormatCount(value)
+}
+
+func (self _FormatSpec) render(value any) (string, error) {
+	numeric := _IsFormatNumeric(value)
+	prefix := ""
+
+	var body string
+	var err error
+
+	switch self.kind {
+	case "?":
+		body = _FormatDebug(value)
+	case "":
+		body, err = _FormatDisplay(value, self.precision)
+	case "x", "X", "b", "o":
+		body, err = _FormatRadix(value, self.kind)
+		if self.alternate {
+			prefix = map[string]string{"x": "0x", "X": "0x", "b": "0b", "o": "0o"}[self.kind]
+		}
+	case "e", "E":
+		body, err = _FormatExp(value, self.kind, self.precision)
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	sign := ""
+	if numeric {
+		if strings.HasPrefix(body, "-") {
+			sign, body = "-", body[1:]
+		} else if self.sign {
+			sign = "+"
+		}
+	}
+
+	length := utf8.RuneCountInString(sign + prefix + body)
+	if self.width <= length {
+		return sign + prefix + body, nil
+	}
+
+	padding := self.width - length
+
+	if self.zero && numeric {
+		return sign + prefix + strings.Repeat("0", padding) + body, nil
+	}
+
+	align := self.align
+	if align == 0 {
+		if numeric {
+			align = '>'
+		} else {
+			align = '<'
+		}
+	}
+
+	fill := string(self.fill)
+	text := sign + prefix + body
+
+	switch align {
+	case '<':
+		return text + strings.Repeat(fill, padding), nil
+	case '^':
+		return strings.Repeat(fill, padding/2) + text + strings.Repeat(fill, padding-padding/2), nil
+	default:
+		return strings.Repeat(fill, padding) + text, nil
+	}
+}
+
+// Looks up a named argument in a map with string keys, a HashMap, or an exported struct field.
+// A struct field may be named like the placeholder or with its first letter capitalized.
+func _LookupNamedArgument(param any, name string) (any, bool) {
+	value := reflect.ValueOf(param)
+
+	for value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return nil, false
+		}
+		value = value.Elem()
+	}
+
+	switch value.Kind() {
+	case reflect.Map:
+		if value.Type().Key().Kind() != reflect.String {
+			return nil, false
+		}
+
+		found := value.MapIndex(reflect.ValueOf(name).Convert(value.Type().Key()))
+		if found.IsValid() {
+			return found.Interface(), true
+		}
+	case reflect.Struct:
+		if asMap := value.MethodByName("AsMap"); asMap.IsValid() && asMap.Type().NumIn() == 0 && asMap.Type().NumOut() == 1 {
+			return _LookupNamedArgument(asMap.Call(nil)[0].Interface(), name)
+		}
+
+		first, size := utf8.DecodeRuneInString(name)
+		capitalized := string(unicode.ToUpper(first)) + name[size:]
+		for _, fieldName := range []string{name, capitalized} {
+			field := value.FieldByName(fieldName)
+
+			if field.IsValid() && field.CanInterface() {
+				return field.Interface(), true
+			}
+		}
+	}
+
+	return nil, false
+}
+
+func _FormatCount(value any) (int, error) {
+	reflected := reflect.ValueOf(value)
+
+	switch reflected.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if reflected.Int() >= 0 {
+			return int(reflected.Int()), nil
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int(reflected.Uint()), nil
+	}
+
+	return -1, _FormatErrorf("width and precision arguments must be non-negative integers, got %v", value)
+}
+
+func _IsFormatNumeric(value any) bool {
+	switch value.(type) {
+	case I128, U128:
+		return true
+	}
+
+	switch reflect.ValueOf(value).Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
+
+func _FormatDisplay(value any, precision int) (string, error) {
+	reflected := reflect.ValueOf(value)
+
+	if precision >= 0 && (reflected.Kind() == reflect.Float32 || reflected.Kind() == reflect.Float64) {
+		return strconv.FormatFloat(reflected.Float(), 'f', precision, reflected.Type().Bits()), nil
+	}
+
+	var text string
+
+	if display, ok := value.(Display[any]); ok {
+		text = string(display.Display())
+	} else if err, ok := value.(error); ok {
+		text = err.Error()
+	} else if stringer, ok := value.(fmt.Stringer); ok {
+		text = stringer.String()
+	} else {
+		switch reflected.Kind() {
+		case reflect.Bool, reflect.String,
+			reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+			reflect.Float32, reflect.Float64:
+			text = fmt.Sprint(value)
+		default:
+			return "", _FormatErrorf("'%T' does not implement Display; use {:?} or implement Display() String", value)
+		}
+	}
+
+	if precision >= 0 && !_IsFormatNumeric(value) && utf8.RuneCountInString(text) > precision {
+		text = string([]rune(text)[:precision])
+	}
+
+	return text, nil
+}
+
+// Debug falls back to Go's %#v syntax for values that do not implement Debug.
+func _FormatDebug(value any) string {
+	if debug, ok := value.(Debug[any]); ok {
+		return string(debug.Debug())
+	}
+
+	return fmt.Sprintf("%#v", value)
+}
+
+// Returns the integer value of value and its size in bits.
+func _FormatInteger(value any) (*big.Int, int, bool) {
+	switch integer := value.(type) {
+	case I128:
+		high := new(big.Int).Lsh(big.NewInt(int64(integer.high)), 64)
+		return high.Add(high, new(big.Int).SetUint64(uint64(integer.low))), 128, true
+	case U128:
+		high := new(big.Int).Lsh(new(big.Int).SetUint64(uint64(integer.high)), 64)
+		return high.Add(high, new(big.Int).SetUint64(uint64(integer.low))), 128, true
+	}
+
+	reflected := reflect.ValueOf(value)
+
+	switch reflected.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return big.NewInt(reflected.Int()), reflected.Type().Bits(), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return new(big.Int).SetUint64(reflected.Uint()), reflected.Type().Bits(), true
+	default:
+		return nil, 0, false
+	}
+}
+
+// Negative integers are printed in two's complement, as in Rust.
+func _FormatRadix(value any, kind string) (string, error) {
+	integer, bits, ok := _FormatInteger(value)
+
+	if !ok {
+		return "", _FormatErrorf("'%T' cannot be formatted with {:%s}; only integers can", value, kind)
+	}
+
+	if integer.Sign() < 0 {
+		integer.Add(integer, new(big.Int).Lsh(big.NewInt(1), uint(bits)))
+	}
+
+	switch kind {
+	case "x":
+		return integer.Text(16), nil
+	case "X":
+		return strings.ToUpper(integer.Text(16)), nil
+	case "b":
+		return integer.Text(2), nil
+	default:
+		return integer.Text(8), nil
+	}
+}
+
+// Formats value in scientific notation with Rust's exponent syntax, e.g. 1.5e3 and 2e-7.
+func _FormatExp(value any, kind string, precision int) (string, error) {
+	var float float64
+
+	if integer, _, ok := _FormatInteger(value); ok {
+		float, _ = new(big.Float).SetInt(integer).Float64()
+	} else if reflected := reflect.ValueOf(value); reflected.Kind() == reflect.Float32 || reflected.Kind() == reflect.Float64 {
+		float = reflected.Float()
+	} else {
+		return "", _FormatErrorf("'%T' cannot be formatted with {:%s}; only numbers can", value, kind)
+	}
+
+	text := strconv.FormatFloat(float, 'e', precision, 64)
+
+	index := strings.IndexByte(text, 'e')
+	if index < 0 {
+		return text, nil
+	}
+
+	exponent, _ := strconv.Atoi(text[index+1:])
+	text = text[:index] + "e" + strconv.Itoa(exponent)
+
+	if kind == "E" {
+		return strings.ToUpper(text), nil
+	}
+
+	return text, nil
+}
@@ -0,0 +1,79 @@
+package gost
+
+import (
+	"strings"
+	"testing"
+)
+
+func Test_Format_Arguments(t *testing.T) {
+	t.Parallel()
+
+	AssertEq(Format("{} + {} = {}", I32(1), I32(2), I32(3)), String("1 + 2 = 3"), "implicit")
+	AssertEq(Format("{1} {0} {1}", String("a"), String("b")), String("b a b"), "positional")
+	AssertEq(Format("{{{}}}", I32(1)), String("{1}"), "escaped braces")
+
+	type user struct {
+		Name String
+		Age  I32
+	}
+	AssertEq(Format("{name} is {age}", user{Name: "gost", Age: 3}), String("gost is 3"), "named from a struct")
+	AssertEq(Format("{étage}", struct{ Étage I32 }{Étage: 2}), String("2"), "named from a struct field starting with a non-ASCII letter")
+	AssertEq(Format("{lang}!", map[string]String{"lang": "go"}), String("go!"), "named from a map")
+
+	hashMap := HashMapNew[String, I32]()
+	hashMap.Insert("count", 7)
+	AssertEq(Format("{count:>3}", hashMap), String("  7"), "named from a HashMap")
+}
+
+func Test_Format_Spec(t *testing.T) {
+	t.Parallel()
+
+	AssertEq(Format("[{:>6}]", String("ab")), String("[    ab]"), "right align")
+	AssertEq(Format("[{:<6}]", I32(12)), String("[12    ]"), "left align")
+	AssertEq(Format("[{:*^7}]", String("mid")), String("[**mid**]"), "center with fill")
+	AssertEq(Format("[{:5}]", I32(42)), String("[   42]"), "numbers align right by default")
+	AssertEq(Format("[{:5}]", String("ab")), String("[ab   ]"), "strings align left by default")
+	AssertEq(Format("{:.3}", F64(3.14159)), String("3.142"), "float precision")
+	AssertEq(Format("{:08.2}", F64(-3.14159)), String("-0003.14"), "zero padding after the sign")
+	AssertEq(Format("{:.2}", String("abcdef")), String("ab"), "string precision truncates")
+	AssertEq(Format("{:+}", I32(5)), String("+5"), "sign")
+	AssertEq(Format("{:x} {:X} {:#x}", I32(255), I32(255), I32(255)), String("ff FF 0xff"), "hex")
+	AssertEq(Format("{:#010b}", U8(5)), String("0b00000101"), "binary with prefix and zero padding")
+	AssertEq(Format("{:o}", I32(8)), String("10"), "octal")
+	AssertEq(Format("{:x}", I8(-1)), String("ff"), "negative hex is two's complement")
+	AssertEq(Format("{:x}", U128_FromU64(255)), String("ff"), "U128 hex")
+	AssertEq(Format("{:e}", F64(1234.5)), String("1.2345e3"), "exponent")
+	AssertEq(Format("{:.1E}", F64(0.00012)), String("1.2E-4"), "exponent with precision")
+	AssertEq(Format("{:1$}|", I32(7), USize(4)), String("   7|"), "width from an argument")
+	AssertEq(Format("{:.*}", USize(1), F64(2.25)), String("2.2"), "precision from the next argument")
+	AssertEq(Format("{:?}", I32(1)), String("I32(1)"), "Debug")
+	AssertEq(Format("{:?}", Some[I32](1)), Some[I32](1).Debug(), "Debug on Option")
+}
+
+func Test_Format_Errors(t *testing.T) {
+	t.Parallel()
+
+	cases := map[String]string{
+		"{} {}":  "argument 1",
+		"{3}":    "argument 3",
+		"{name}": "no argument named 'name'",
+		"{:q}":   "unknown format trait 'q'",
+		"{":      "unclosed '{'",
+		"}":      "unmatched '}'",
+	}
+
+	for format, message := range cases {
+		result := TryFormat(format, I32(1))
+		Assert(result.IsErr(), "TryFormat must fail")
+		Assert(Bool(strings.Contains(result.UnwrapErr().Error(), message)), "descriptive error")
+	}
+
+	Assert(TryFormat("{}", I32(1), I32(2)).IsErr(), "unused argument")
+	Assert(TryFormat("{:x}", String("a")).IsErr(), "hex on a string")
+
+	defer func() {
+		message, _ := recover().(string)
+		Assert(Bool(strings.Contains(message, "2 argument(s) were given")), "Format panics with the reason")
+	}()
+	Format("{} {} {}", I32(1), I32(2))
+}
@@ -1,13 +1,27 @@
 package gost
 
 import (
+	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 )
 
 // Creates a new, empty directory at the provided path
-func CreateDir(path String) Result[any] {
-	err := os.Mkdir(string(path), os.ModePerm)
+func CreateDir[P AsPath](path P) Result[any] {
+	err := os.Mkdir(_PathString(path), os.ModePerm)
+
+	if err != nil {
+		return Err[any](err)
+	} else {
+		return Ok[any](nil)
+	}
+}
+
+// Recursively create a directory and all of its parent components if they are missing.
+// It is not an error if the directory already exists.
+func CreateDirAll[P AsPath](path P) Result[any] {
+	err := os.MkdirAll(_PathString(path), os.ModePerm)
 
 	if err != nil {
 		return Err[any](err)
@@ -17,8 +31,27 @@ func CreateDir(path String) Result[any] {
 }
 
 // Removes an empty directory.
-func RemoveDir(path String) Result[any] {
-	err := os.Remove(string(path))
+func RemoveDir[P AsPath](path P) Result[any] {
+	err := os.Remove(_PathString(path))
+
+	if err != nil {
+		return Err[any](err)
+	} else {
+		return Ok[any](nil)
+	}
+}
+
+// Removes a directory at this path, after removing all its contents. Symbolic links are removed, not followed.
+func RemoveDirAll[P AsPath](path P) Result[any] {
+	info, err := os.Lstat(_PathString(path))
+
+	if err == nil && !info.IsDir() {
+		err = &os.PathError{Op: "remove", Path: _PathString(path), Err: fmt.Errorf("%w: not a directory", os.ErrInvalid)}
+	}
+
+	if err == nil {
+		err = os.RemoveAll(_PathString(path))
+	}
 
 	if err != nil {
 		return Err[any](err)
@@ -28,8 +61,8 @@ func RemoveDir(path String) Result[any] {
 }
 
 // Write a slice as the entire contents of a file.
-func Write(path String, data []Byte) Result[any] {
-	file, err := os.Create(string(path))
+func Write[P AsPath](path P, data []Byte) Result[any] {
+	file, err := os.Create(_PathString(path))
 
 	if err != nil {
 		return Err[any](err)
@@ -53,8 +86,8 @@ func Write(path String, data []Byte) Result[any] {
 }
 
 // Removes a file from the filesystem.
-func RemoveFile(path String) Result[any] {
-	err := os.Remove(string(path))
+func RemoveFile[P AsPath](path P) Result[any] {
+	err := os.Remove(_PathString(path))
 
 	if err != nil {
 		return Err[any](err)
@@ -65,8 +98,8 @@ func RemoveFile(path String) Result[any] {
 
 // Rename a file or directory to a new name, replacing the original file if to already exists.
 // This will not work if the new name is on a different mount point.
-func Rename(from String, to String) Result[any] {
-	err := os.Rename(string(from), string(to))
+func Rename[F AsPath, T AsPath](from F, to T) Result[any] {
+	err := os.Rename(_PathString(from), _PathString(to))
 
 	if err != nil {
 		return Err[any](err)
@@ -76,8 +109,8 @@ func Rename(from String, to String) Result[any] {
 }
 
 // Read the entire contents of a file into a bytes vector.
-func Read(path String) Result[[]Byte] {
-	file, err := os.Open(string(path))
+func Read[P AsPath](path P) Result[[]Byte] {
+	file, err := os.Open(_PathString(path))
 
 	if err != nil {
 		return Err[[]Byte](err)
@@ -124,38 +157,38 @@ func (self FileType) IsSymlink() bool {
 	return self.typeInfo == "symlink"
 }
 
+func _FileTypeFromMode(mode os.FileMode) FileType {
+	if mode.IsDir() {
+		return FileType{typeInfo: "dir"}
+	} else if mode.IsRegular() {
+		return FileType{typeInfo: "file"}
+	} else if mode&os.ModeSymlink != 0 {
+		return FileType{typeInfo: "symlink"}
+	} else {
+		return FileType{}
+	}
+}
+
 type DirEntry struct {
 	FileName String
-	Path     String
+	Path     PathBuf
 	FileType FileType
 }
 
 // Returns an iterator over the entries within a directory.
-func ReadDir(path String) Result[Vec[DirEntry]] {
-	entries, err := os.ReadDir(string(path))
+func ReadDir[P AsPath](path P) Result[Vec[DirEntry]] {
+	entries, err := os.ReadDir(_PathString(path))
 
 	if err != nil {
 		return Err[Vec[DirEntry]](err)
 	} else {
-		vec := VecWithLen[DirEntry](USize(len(entries)))
+		vec := VecWithCapacity[DirEntry](USize(len(entries)))
 
 		for _, entry := range entries {
-			fileType := FileType{}
-
-			if entry.Type().Perm().IsDir() {
-				fileType.typeInfo = "dir"
-			} else if entry.Type().Perm().IsRegular() {
-				fileType.typeInfo = "file"
-			} else if entry.Type().Perm()&os.ModeSymlink != 0 {
-				fileType.typeInfo = "symlink"
-			}
-
-			entryPath := filepath.Join(string(path), entry.Name())
-
 			vec.Push(DirEntry{
 				FileName: String(entry.Name()),
-				FileType: fileType,
-				Path:     String(entryPath),
+				FileType: _FileTypeFromMode(entry.Type()),
+				Path:     PathOf(path).Join(Path(entry.Name())),
 			})
 		}
 
@@ -163,10 +196,181 @@ func ReadDir(path String) Result[Vec[DirEntry]] {
 	}
 }
 
+// Returns the metadata for the file that this entry points at. Symbolic links are not followed.
+func (self DirEntry) Metadata() Result[Metadata] {
+	return SymlinkMetadata(self.Path)
+}
+
+// Metadata information about a file.
+// It is returned by MetadataOf, SymlinkMetadata, File.Metadata and DirEntry.Metadata.
+type Metadata struct {
+	info os.FileInfo
+}
+
+// Given a path, queries the file system to get information about a file, directory, etc.
+// This function will traverse symbolic links to query information about the destination file.
+//
+//	metadata := gost.MetadataOf("foo.txt").Unwrap()
+//	gost.Println("{} bytes", metadata.Len())
+func MetadataOf[P AsPath](path P) Result[Metadata] {
+	info, err := os.Stat(_PathString(path))
+
+	if err != nil {
+		return Err[Metadata](err)
+	} else {
+		return Ok(Metadata{info: info})
+	}
+}
+
+// Queries the metadata about a file without following symlinks.
+func SymlinkMetadata[P AsPath](path P) Result[Metadata] {
+	info, err := os.Lstat(_PathString(path))
+
+	if err != nil {
+		return Err[Metadata](err)
+	} else {
+		return Ok(Metadata{info: info})
+	}
+}
+
+// Returns the file type for this metadata.
+func (self Metadata) FileType() FileType {
+	return _FileTypeFromMode(self.info.Mode())
+}
+
+// Returns true if this metadata is for a directory.
+func (self Metadata) IsDir() bool {
+	return self.info.IsDir()
+}
+
+// Returns true if this metadata is for a regular file.
+func (self Metadata) IsFile() bool {
+	return self.info.Mode().IsRegular()
+}
+
+// Returns true if this metadata is for a symbolic link. Only SymlinkMetadata and DirEntry.Metadata can return it.
+func (self Metadata) IsSymlink() bool {
+	return self.info.Mode()&os.ModeSymlink != 0
+}
+
+// Returns the size of the file, in bytes, this metadata is for.
+func (self Metadata) Len() U64 {
+	return U64(self.info.Size())
+}
+
+// Returns the permissions of the file this metadata is for.
+func (self Metadata) Permissions() Permissions {
+	return Permissions{mode: self.info.Mode().Perm()}
+}
+
+// Returns the last modification time listed in this metadata.
+func (self Metadata) Modified() Result[SystemTime] {
+	return Ok(_SystemTimeFromGo(self.info.ModTime()))
+}
+
+// Returns the last access time of this metadata.
+// Many platforms do not update the access time on every read, so it may be older than expected.
+func (self Metadata) Accessed() Result[SystemTime] {
+	return _AccessedTime(self.info)
+}
+
+// Returns the creation time listed in this metadata.
+// Returns an Err on platforms where the creation time is not available, such as Linux.
+func (self Metadata) Created() Result[SystemTime] {
+	return _CreatedTime(self.info)
+}
+
+func _ErrTimeUnsupported(kind string) error {
+	return fmt.Errorf("the %s time is not available on this platform", kind)
+}
+
+// Representation of the various permissions on a file.
+type Permissions struct {
+	mode os.FileMode
+}
+
+// Creates a new instance of Permissions from the given set of Unix permission bits.
+func PermissionsFromMode(mode U32) Permissions {
+	return Permissions{mode: os.FileMode(mode) & os.ModePerm}
+}
+
+// Returns true if these permissions describe a readonly (unwritable) file.
+func (self Permissions) Readonly() bool {
+	return self.mode&0o222 == 0
+}
+
+// Modifies the readonly flag for this set of permissions. Making a file writable adds write access for its owner only.
+// This does not modify the files attributes; use SetPermissions for that.
+func (self *Permissions) SetReadonly(readonly Bool) {
+	if readonly {
+		self.mode &^= 0o222
+	} else {
+		self.mode |= 0o200
+	}
+}
+
+// Returns the underlying raw Unix permission bits.
+func (self Permissions) Mode() U32 {
+	return U32(self.mode)
+}
+
+// Sets the underlying raw Unix permission bits.
+func (self *Permissions) SetMode(mode U32) {
+	self.mode = os.FileMode(mode) & os.ModePerm
+}
+
+// Changes the permissions found on a file or a directory.
+//
+//	permissions := gost.MetadataOf("foo.txt").Unwrap().Permissions()
+//	permissions.SetReadonly(true)
+//	gost.SetPermissions("foo.txt", permissions)
+func SetPermissions[P AsPath](path P, permissions Permissions) Result[any] {
+	err := os.Chmod(_PathString(path), permissions.mode)
+
+	if err != nil {
+		return Err[any](err)
+	} else {
+		return Ok[any](nil)
+	}
+}
+
 // Copies the contents of one file to another. This function will also copy the permission bits of the original file to the destination file.
 // This function will overwrite the contents of to.
-func Copy(from String, to String) Result[any] {
-	err := os.Link(string(from), string(to))
+func Copy[F AsPath, T AsPath](from F, to T) Result[any] {
+	source, err := os.Open(_PathString(from))
+
+	if err != nil {
+		return Err[any](err)
+	}
+
+	defer source.Close()
+
+	info, err := source.Stat()
+
+	if err != nil {
+		return Err[any](err)
+	}
+
+	if !info.Mode().IsRegular() {
+		return Err[any](&os.PathError{Op: "copy", Path: _PathString(from), Err: fmt.Errorf("%w: the source path is not a regular file", os.ErrInvalid)})
+	}
+
+	destination, err := os.OpenFile(_PathString(to), os.O_WRONLY|os.O_CREATE|os.O_TRUNC, info.Mode().Perm())
+
+	if err != nil {
+		return Err[any](err)
+	}
+
+	_, err = io.Copy(destination, source)
+
+	if err == nil {
+		// The mode given to OpenFile only applies to new files, and is subject to the umask.
+		err = destination.Chmod(info.Mode().Perm())
+	}
+
+	if closeErr := destination.Close(); err == nil {
+		err = closeErr
+	}
 
 	if err != nil {
 		return Err[any](err)
@@ -176,8 +380,8 @@ func Copy(from String, to String) Result[any] {
 }
 
 // Read the entire contents of a file into a string.
-func ReadToString(path String) Result[String] {
-	file, err := os.Open(string(path))
+func ReadToString[P AsPath](path P) Result[String] {
+	file, err := os.Open(_PathString(path))
 
 	if err != nil {
 		return Err[String](err)
@@ -201,3 +405,342 @@ func ReadToString(path String) Result[String] {
 		return Ok[String](String(string(data)))
 	}
 }
+
+// Returns the canonical, absolute form of a path with all intermediate components normalized and symbolic links resolved.
+// The path must exist.
+func Canonicalize[P AsPath](path P) Result[PathBuf] {
+	absolute, err := filepath.Abs(_PathString(path))
+
+	if err == nil {
+		absolute, err = filepath.EvalSymlinks(absolute)
+	}
+
+	if err != nil {
+		return Err[PathBuf](err)
+	} else {
+		return Ok(PathBufFrom(absolute))
+	}
+}
+
+// Reads a symbolic link, returning the file that the link points to.
+func ReadLink[P AsPath](path P) Result[PathBuf] {
+	target, err := os.Readlink(_PathString(path))
+
+	if err != nil {
+		return Err[PathBuf](err)
+	} else {
+		return Ok(PathBufFrom(target))
+	}
+}
+
+// Creates a new symbolic link on the filesystem. The link path will be a symbolic link pointing to the original path.
+func Symlink[O AsPath, L AsPath](original O, link L) Result[any] {
+	err := os.Symlink(_PathString(original), _PathString(link))
+
+	if err != nil {
+		return Err[any](err)
+	} else {
+		return Ok[any](nil)
+	}
+}
+
+// Creates a new hard link on the filesystem. The link path will be a link pointing to the original path.
+func HardLink[O AsPath, L AsPath](original O, link L) Result[any] {
+	err := os.Link(_PathString(original), _PathString(link))
+
+	if err != nil {
+		return Err[any](err)
+	} else {
+		return Ok[any](nil)
+	}
+}
+
+// An object providing access to an open file on the filesystem.
+// A File is not closed automatically; call Close once it is no longer needed.
+type File struct {
+	file *os.File
+}
+
+// Attempts to open a file in read-only mode.
+//
+//	file := gost.FileOpen("foo.txt").Unwrap()
+//	defer file.Close()
+func FileOpen[P AsPath](path P) Result[File] {
+	return OpenOptionsOpen(OpenOptionsNew().Read(true), path)
+}
+
+// Opens a file in write-only mode. This function will create a file if it does not exist, and will truncate it if it does.
+//
+//	file := gost.FileCreate("foo.txt").Unwrap()
+//	defer file.Close()
+//	file.WriteAll([]gost.Byte("Hello, world!"))
+func FileCreate[P AsPath](path P) Result[File] {
+	return OpenOptionsOpen(OpenOptionsNew().Write(true).Create(true).Truncate(true), path)
+}
+
+// Creates a new file in read-write mode. This function will return an error if the file already exists.
+func FileCreateNew[P AsPath](path P) Result[File] {
+	return OpenOptionsOpen(OpenOptionsNew().Read(true).Write(true).CreateNew(true), path)
+}
+
+// Wraps an *os.File opened by the standard library. The File takes over closing it.
+func FileFromOs(file *os.File) File {
+	return File{file: file}
+}
+
+// Returns the underlying *os.File, for use with the standard library.
+func (self File) AsOs() *os.File {
+	return self.file
+}
+
+// Closes the file. Reading from or writing to a closed File returns an error.
+func (self File) Close() Result[any] {
+	err := self.file.Close()
+
+	if err != nil {
+		return Err[any](err)
+	} else {
+		return Ok[any](nil)
+	}
+}
+
+// impl Reader for File
+func (self File) Read(buffer []Byte) Result[USize] {
+	return IoReader(self.file).Read(buffer)
+}
+
+// Reads all bytes until the end of the file, appending them to buffer.
+func (self File) ReadToEnd(buffer *Vec[Byte]) Result[USize] {
+	reader := BufReaderNew[Reader](self)
+	return reader.ReadToEnd(buffer)
+}
+
+// Reads all bytes until the end of the file, appending them to buffer as UTF-8.
+// If the data is not valid UTF-8, an error is returned and buffer is unchanged.
+func (self File) ReadToString(buffer *String) Result[USize] {
+	reader := BufReaderNew[Reader](self)
+	return reader.ReadToString(buffer)
+}
+
+// impl Writer for File
+func (self File) Write(buffer []Byte) Result[USize] {
+	return IoWriter(self.file).Write(buffer)
+}
+
+// Attempts to write an entire buffer into this file.
+func (self File) WriteAll(buffer []Byte) Result[any] {
+	return WriteAll(self, buffer)
+}
+
+// impl Writer for File
+// A File does not buffer writes, so this does nothing. Use SyncAll to make sure the data reaches the disk.
+func (self File) Flush() Result[any] {
+	return Ok[any](nil)
+}
+
+// Enumeration of possible methods to seek within a File.
+// It is created by SeekFromStart, SeekFromEnd and SeekFromCurrent.
+type SeekFrom struct {
+	whence int
+	offset I64
+}
+
+// Sets the offset to the provided number of bytes.
+func SeekFromStart(offset U64) SeekFrom {
+	return SeekFrom{whence: io.SeekStart, offset: I64(offset)}
+}
+
+// Sets the offset to the size of the file plus the specified number of bytes.
+func SeekFromEnd(offset I64) SeekFrom {
+	return SeekFrom{whence: io.SeekEnd, offset: offset}
+}
+
+// Sets the offset to the current position plus the specified number of bytes.
+func SeekFromCurrent(offset I64) SeekFrom {
+	return SeekFrom{whence: io.SeekCurrent, offset: offset}
+}
+
+// Seek to an offset, in bytes, in the file. Returns the new position from the start of the file.
+// Seeking beyond the end of the file is allowed; a write there fills the gap with zeros.
+//
+//	file.Seek(gost.SeekFromEnd(-5))
+func (self File) Seek(position SeekFrom) Result[U64] {
+	offset, err := self.file.Seek(int64(position.offset), position.whence)
+
+	if err != nil {
+		return Err[U64](err)
+	} else {
+		return Ok(U64(offset))
+	}
+}
+
+// Rewinds to the beginning of the file.
+func (self File) Rewind() Result[any] {
+	result := self.Seek(SeekFromStart(0))
+
+	if result.IsErr() {
+		return Err[any](result.UnwrapErr())
+	} else {
+		return Ok[any](nil)
+	}
+}
+
+// Returns the current position from the start of the file.
+func (self File) StreamPosition() Result[U64] {
+	return self.Seek(SeekFromCurrent(0))
+}
+
+// Truncates or extends the underlying file, updating its size to become size.
+// If size is greater than the current size, the file is extended with zeros. The cursor is not moved.
+func (self File) SetLen(size U64) Result[any] {
+	err := self.file.Truncate(int64(size))
+
+	if err != nil {
+		return Err[any](err)
+	} else {
+		return Ok[any](nil)
+	}
+}
+
+// Attempts to sync all OS-internal file content and metadata to disk.
+func (self File) SyncAll() Result[any] {
+	err := self.file.Sync()
+
+	if err != nil {
+		return Err[any](err)
+	} else {
+		return Ok[any](nil)
+	}
+}
+
+// Queries metadata about the underlying file.
+func (self File) Metadata() Result[Metadata] {
+	info, err := self.file.Stat()
+
+	if err != nil {
+		return Err[Metadata](err)
+	} else {
+		return Ok(Metadata{info: info})
+	}
+}
+
+// Options and flags which can be used to configure how a file is opened.
+// Every option is false by default, and the mode of a created file is 0o666 before the umask.
+//
+//	file := gost.OpenOptionsOpen(gost.OpenOptionsNew().Append(true).Create(true), "log.txt")
+type OpenOptions struct {
+	read      bool
+	write     bool
+	append    bool
+	truncate  bool
+	create    bool
+	createNew bool
+	mode      U32
+}
+
+// Creates a blank new set of options ready for configuration.
+func OpenOptionsNew() OpenOptions {
+	return OpenOptions{mode: 0o666}
+}
+
+// Sets the option for read access.
+func (self OpenOptions) Read(read Bool) OpenOptions {
+	self.read = bool(read)
+	return self
+}
+
+// Sets the option for write access.
+func (self OpenOptions) Write(write Bool) OpenOptions {
+	self.write = bool(write)
+	return self
+}
+
+// Sets the option for the append mode. Writes will append to the file instead of overwriting its contents.
+// Append implies write access.
+func (self OpenOptions) Append(append Bool) OpenOptions {
+	self.append = bool(append)
+	return self
+}
+
+// Sets the option for truncating a previous file. The file must be opened with write access.
+func (self OpenOptions) Truncate(truncate Bool) OpenOptions {
+	self.truncate = bool(truncate)
+	return self
+}
+
+// Sets the option to create a new file, or open it if it already exists. The file must be opened with write or append access.
+func (self OpenOptions) Create(create Bool) OpenOptions {
+	self.create = bool(create)
+	return self
+}
+
+// Sets the option to create a new file, failing if it already exists. Create and Truncate are ignored when it is set.
+func (self OpenOptions) CreateNew(createNew Bool) OpenOptions {
+	self.createNew = bool(createNew)
+	return self
+}
+
+// Sets the mode bits that a new file will be created with, before the umask is applied.
+func (self OpenOptions) Mode(mode U32) OpenOptions {
+	self.mode = mode
+	return self
+}
+
+// Opens a file at path with the given options.
+// Returns an error wrapping os.ErrInvalid if the combination of options is invalid, such as Truncate without write access.
+func OpenOptionsOpen[P AsPath](options OpenOptions, path P) Result[File] {
+	flags, err := options.flags()
+
+	if err != nil {
+		return Err[File](&os.PathError{Op: "open", Path: _PathString(path), Err: err})
+	}
+
+	file, err := os.OpenFile(_PathString(path), flags, os.FileMode(options.mode)&os.ModePerm)
+
+	if err != nil {
+		return Err[File](err)
+	} else {
+		return Ok(File{file: file})
+	}
+}
+
+func (self OpenOptions) flags() (int, error) {
+	flags := 0
+
+	switch {
+	case self.read && !self.write && !self.append:
+		flags = os.O_RDONLY
+	case !self.read && (self.write || self.append):
+		flags = os.O_WRONLY
+	case self.read && (self.write || self.append):
+		flags = os.O_RDWR
+	default:
+		return 0, fmt.Errorf("%w: no read, write or append access was requested", os.ErrInvalid)
+	}
+
+	if self.append {
+		flags |= os.O_APPEND
+	}
+
+	if !self.write && !self.append && (self.truncate || self.create || self.createNew) {
+		return 0, fmt.Errorf("%w: creating or truncating a file requires write access", os.ErrInvalid)
+	}
+
+	if self.append && self.truncate && !self.createNew {
+		return 0, fmt.Errorf("%w: a file cannot be both truncated and appended to", os.ErrInvalid)
+	}
+
+	if self.createNew {
+		flags |= os.O_CREATE | os.O_EXCL
+	} else {
+		if self.create {
+			flags |= os.O_CREATE
+		}
+
+		if self.truncate {
+			flags |= os.O_TRUNC
+		}
+	}
+
+	return flags, nil
+}
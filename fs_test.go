@@ -0,0 +1,218 @@
+package gost
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func Test_File_ReadWrite(t *testing.T) {
+	t.Parallel()
+
+	path := String(filepath.Join(t.TempDir(), "file.txt"))
+
+	file := FileCreate(path).Unwrap()
+	Assert(file.WriteAll([]Byte("hello\nworld\n")).IsOk(), "WriteAll")
+	Assert(file.SyncAll().IsOk(), "SyncAll")
+	Assert(file.Close().IsOk(), "Close")
+
+	file = FileOpen(path).Unwrap()
+	defer file.Close()
+
+	reader := BufReaderNew(file)
+	line := String("")
+	AssertEq(reader.ReadLine(&line), Ok[USize](6), "ReadLine")
+	AssertEq(line, String("hello\n"), "ReadLine")
+
+	Assert(file.Write([]Byte("x")).IsErr(), "a file opened for reading cannot be written")
+}
+
+func Test_File_Seek_SetLen(t *testing.T) {
+	t.Parallel()
+
+	path := String(filepath.Join(t.TempDir(), "file.txt"))
+
+	file := FileCreateNew(path).Unwrap()
+	defer file.Close()
+
+	Assert(file.WriteAll([]Byte("0123456789")).IsOk(), "WriteAll")
+	AssertEq(file.StreamPosition(), Ok[U64](10), "StreamPosition")
+
+	AssertEq(file.Seek(SeekFromEnd(-3)), Ok[U64](7), "SeekFromEnd")
+	AssertEq(file.Seek(SeekFromCurrent(-2)), Ok[U64](5), "SeekFromCurrent")
+
+	buffer := make([]Byte, 2)
+	AssertEq(file.Read(buffer), Ok[USize](2), "Read after Seek")
+	AssertEq(String(buffer), String("56"), "Read after Seek")
+
+	Assert(file.SetLen(4).IsOk(), "SetLen shrinks")
+	AssertEq(file.Metadata().Unwrap().Len(), U64(4), "SetLen shrinks")
+	Assert(file.SetLen(8).IsOk(), "SetLen extends")
+	AssertEq(file.Metadata().Unwrap().Len(), U64(8), "SetLen extends")
+	Assert(Bool(file.Metadata().Unwrap().IsFile()), "IsFile")
+
+	Assert(file.Rewind().IsOk(), "Rewind")
+	content := String("")
+	AssertEq(file.ReadToString(&content), Ok[USize](8), "ReadToString")
+	AssertEq(content, String("0123\x00\x00\x00\x00"), "extended with zeros")
+
+	Assert(Bool(errors.Is(FileCreateNew(path).UnwrapErr(), os.ErrExist)), "CreateNew fails on an existing file")
+}
+
+func Test_OpenOptions(t *testing.T) {
+	t.Parallel()
+
+	path := String(filepath.Join(t.TempDir(), "log.txt"))
+
+	Assert(OpenOptionsOpen(OpenOptionsNew().Append(true), path).IsErr(), "Append without Create on a missing file")
+
+	for _, line := range []String{"first\n", "second\n"} {
+		file := OpenOptionsOpen(OpenOptionsNew().Append(true).Create(true), path).Unwrap()
+		Assert(file.WriteAll([]Byte(line)).IsOk(), "append")
+		file.Close()
+	}
+
+	AssertEq(ReadToString(path), Ok[String]("first\nsecond\n"), "Append keeps the previous contents")
+
+	file := OpenOptionsOpen(OpenOptionsNew().Write(true).Truncate(true), path).Unwrap()
+	file.Close()
+	AssertEq(ReadToString(path), Ok[String](""), "Truncate")
+
+	newPath := String(filepath.Join(t.TempDir(), "private.txt"))
+	file = OpenOptionsOpen(OpenOptionsNew().Write(true).CreateNew(true).Mode(0o600), newPath).Unwrap()
+	file.Close()
+	info, _ := os.Stat(string(newPath))
+	Assert(Bool(info.Mode().Perm() == 0o600), "Mode")
+
+	for _, options := range []OpenOptions{
+		OpenOptionsNew(),
+		OpenOptionsNew().Read(true).Create(true),
+		OpenOptionsNew().Append(true).Truncate(true),
+	} {
+		Assert(Bool(errors.Is(OpenOptionsOpen(options, path).UnwrapErr(), os.ErrInvalid)), "invalid options")
+	}
+}
+
+func Test_ReadDir_FileType(t *testing.T) {
+	t.Parallel()
+
+	dir := String(t.TempDir())
+	Assert(CreateDir(String(filepath.Join(string(dir), "sub"))).IsOk(), "CreateDir")
+	Assert(Write(String(filepath.Join(string(dir), "file.txt")), []Byte("x")).IsOk(), "Write")
+	Assert(Symlink("file.txt", String(filepath.Join(string(dir), "link"))).IsOk(), "Symlink")
+
+	entries := ReadDir(dir).Unwrap()
+	AssertEq(entries.Len(), USize(3), "no empty entries")
+
+	for _, entry := range entries.AsSlice() {
+		switch entry.FileName {
+		case "file.txt":
+			Assert(Bool(entry.FileType.IsFile()), "file")
+		case "link":
+			Assert(Bool(entry.FileType.IsSymlink()), "symlink")
+			Assert(Bool(entry.Metadata().Unwrap().IsSymlink()), "symlink metadata")
+		case "sub":
+			Assert(Bool(entry.FileType.IsDir()), "dir")
+		}
+	}
+
+	AssertEq(ReadLink(Path(dir).Join("link")), Ok(PathBufFrom("file.txt")), "ReadLink")
+	AssertEq(Canonicalize(String(filepath.Join(string(dir), "sub", "..", "link"))), Canonicalize(String(filepath.Join(string(dir), "file.txt"))), "Canonicalize")
+}
+
+func Test_Metadata(t *testing.T) {
+	t.Parallel()
+
+	path := String(filepath.Join(t.TempDir(), "file.txt"))
+	before := SystemTimeNow().CheckedSub(DurationFromSecs(1)).Unwrap()
+	Assert(Write(path, []Byte("hello")).IsOk(), "Write")
+
+	metadata := MetadataOf(path).Unwrap()
+	AssertEq(metadata.Len(), U64(5), "Len")
+	Assert(Bool(metadata.IsFile() && !metadata.IsDir() && !metadata.IsSymlink()), "file type")
+
+	modified := metadata.Modified().Unwrap()
+	Assert(modified.DurationSince(before).IsOk(), "modified after the file was written")
+	Assert(before.DurationSince(modified).IsErr(), "DurationSince an earlier time")
+	Assert(metadata.Accessed().IsOk(), "Accessed")
+
+	permissions := metadata.Permissions()
+	permissions.SetReadonly(true)
+	Assert(SetPermissions(path, permissions).IsOk(), "SetPermissions")
+	Assert(Bool(MetadataOf(path).Unwrap().Permissions().Readonly()), "Readonly")
+
+	copied := String(filepath.Join(t.TempDir(), "copy.txt"))
+	Assert(Copy(path, copied).IsOk(), "Copy")
+	AssertEq(ReadToString(copied), Ok[String]("hello"), "Copy copies the contents")
+	AssertEq(MetadataOf(copied).Unwrap().Permissions().Mode(), permissions.Mode(), "Copy copies the permissions")
+	Assert(Bool(!os.SameFile(metadata.info, MetadataOf(copied).Unwrap().info)), "Copy is not a hard link")
+
+	linked := String(filepath.Join(t.TempDir(), "link.txt"))
+	Assert(HardLink(path, linked).IsOk(), "HardLink")
+	Assert(Bool(os.SameFile(metadata.info, MetadataOf(linked).Unwrap().info)), "HardLink")
+}
+
+func Test_CreateDirAll_RemoveDirAll(t *testing.T) {
+	t.Parallel()
+
+	root := String(filepath.Join(t.TempDir(), "a"))
+	nested := String(filepath.Join(string(root), "b", "c"))
+
+	Assert(CreateDirAll(nested).IsOk(), "CreateDirAll")
+	Assert(CreateDirAll(nested).IsOk(), "CreateDirAll on an existing directory")
+	Assert(Bool(MetadataOf(nested).Unwrap().IsDir()), "CreateDirAll")
+
+	Assert(Write(String(filepath.Join(string(nested), "file.txt")), []Byte("x")).IsOk(), "Write")
+	Assert(RemoveDirAll(String(filepath.Join(string(nested), "file.txt"))).IsErr(), "RemoveDirAll on a file")
+	Assert(RemoveDirAll(root).IsOk(), "RemoveDirAll")
+	Assert(MetadataOf(root).IsErr(), "RemoveDirAll")
+}
+
+func _WalkPaths(root String, walk WalkDir) Vec[String] {
+	paths := VecNew[String]()
+	iter := walk.IntoIter()
+
+	for entry := iter.Next(); entry.IsSome(); entry = iter.Next() {
+		if entry.Unwrap().IsErr() {
+			paths.Push("error")
+			continue
+		}
+
+		relative := entry.Unwrap().Unwrap().Path.StripPrefix(Path(root)).Unwrap()
+		if relative == "" {
+			relative = "."
+		}
+		paths.Push(String(filepath.ToSlash(string(relative))))
+	}
+
+	return paths
+}
+
+func Test_WalkDir(t *testing.T) {
+	t.Parallel()
+
+	root := String(t.TempDir())
+	Assert(CreateDirAll(String(filepath.Join(string(root), "a", "b"))).IsOk(), "CreateDirAll")
+	Assert(Write(String(filepath.Join(string(root), "a", "b", "file.txt")), []Byte("x")).IsOk(), "Write")
+	Assert(Write(String(filepath.Join(string(root), "z.txt")), []Byte("x")).IsOk(), "Write")
+	Assert(Symlink(root, String(filepath.Join(string(root), "a", "loop"))).IsOk(), "Symlink")
+
+	expected := VecNew[String]()
+	for _, path := range []String{".", "a", "a/b", "a/b/file.txt", "a/loop", "z.txt"} {
+		expected.Push(path)
+	}
+	AssertEq(_WalkPaths(root, WalkDirNew(root)), expected, "WalkDir")
+
+	expected = VecNew[String]()
+	for _, path := range []String{"a", "z.txt"} {
+		expected.Push(path)
+	}
+	AssertEq(_WalkPaths(root, WalkDirNew(root).MinDepth(1).MaxDepth(1)), expected, "depth limits")
+
+	expected = VecNew[String]()
+	for _, path := range []String{".", "a", "a/b", "a/b/file.txt", "error", "z.txt"} {
+		expected.Push(path)
+	}
+	AssertEq(_WalkPaths(root, WalkDirNew(root).FollowLinks(true)), expected, "a followed link to an ancestor is a loop")
+}
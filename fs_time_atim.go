@@ -0,0 +1,23 @@
+//go:build linux || openbsd || dragonfly || solaris || illumos
+
+package gost
+
+import (
+	"os"
+	"syscall"
+	"time"
+)
+
+func _AccessedTime(info os.FileInfo) Result[SystemTime] {
+	stat, ok := info.Sys().(*syscall.Stat_t)
+
+	if !ok {
+		return Err[SystemTime](_ErrTimeUnsupported("access"))
+	}
+
+	return Ok(_SystemTimeFromGo(time.Unix(stat.Atim.Unix())))
+}
+
+func _CreatedTime(info os.FileInfo) Result[SystemTime] {
+	return Err[SystemTime](_ErrTimeUnsupported("creation"))
+}
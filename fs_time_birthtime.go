@@ -0,0 +1,29 @@
+//go:build darwin || freebsd || netbsd
+
+package gost
+
+import (
+	"os"
+	"syscall"
+	"time"
+)
+
+func _AccessedTime(info os.FileInfo) Result[SystemTime] {
+	stat, ok := info.Sys().(*syscall.Stat_t)
+
+	if !ok {
+		return Err[SystemTime](_ErrTimeUnsupported("access"))
+	}
+
+	return Ok(_SystemTimeFromGo(time.Unix(stat.Atimespec.Unix())))
+}
+
+func _CreatedTime(info os.FileInfo) Result[SystemTime] {
+	stat, ok := info.Sys().(*syscall.Stat_t)
+
+	if !ok {
+		return Err[SystemTime](_ErrTimeUnsupported("creation"))
+	}
+
+	return Ok(_SystemTimeFromGo(time.Unix(stat.Birthtimespec.Unix())))
+}
@@ -0,0 +1,13 @@
+//go:build !(linux || openbsd || dragonfly || solaris || illumos || darwin || freebsd || netbsd || windows)
+
+package gost
+
+import "os"
+
+func _AccessedTime(info os.FileInfo) Result[SystemTime] {
+	return Err[SystemTime](_ErrTimeUnsupported("access"))
+}
+
+func _CreatedTime(info os.FileInfo) Result[SystemTime] {
+	return Err[SystemTime](_ErrTimeUnsupported("creation"))
+}
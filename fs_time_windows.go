@@ -0,0 +1,29 @@
+//go:build windows
+
+package gost
+
+import (
+	"os"
+	"syscall"
+	"time"
+)
+
+func _AccessedTime(info os.FileInfo) Result[SystemTime] {
+	data, ok := info.Sys().(*syscall.Win32FileAttributeData)
+
+	if !ok {
+		return Err[SystemTime](_ErrTimeUnsupported("access"))
+	}
+
+	return Ok(_SystemTimeFromGo(time.Unix(0, data.LastAccessTime.Nanoseconds())))
+}
+
+func _CreatedTime(info os.FileInfo) Result[SystemTime] {
+	data, ok := info.Sys().(*syscall.Win32FileAttributeData)
+
+	if !ok {
+		return Err[SystemTime](_ErrTimeUnsupported("creation"))
+	}
+
+	return Ok(_SystemTimeFromGo(time.Unix(0, data.CreationTime.Nanoseconds())))
+}
@@ -0,0 +1,190 @@
+package gokio
+
+import (
+	"sync"
+
+	"github.com/myyrakle/gost"
+)
+
+type _BroadcastState[T any] struct {
+	lock      sync.Mutex
+	changed   _Changed
+	buffer    []T
+	head      gost.U64
+	senders   gost.USize
+	receivers gost.USize
+}
+
+// Returns the position of the oldest value still in the buffer. Must be called with the lock held.
+func (self *_BroadcastState[T]) oldest() gost.U64 {
+	capacity := gost.U64(len(self.buffer))
+
+	if self.head > capacity {
+		return self.head - capacity
+	}
+
+	return 0
+}
+
+func (self *_BroadcastState[T]) subscribe() BroadcastReceiver[T] {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	self.receivers++
+	next := self.head
+
+	return BroadcastReceiver[T]{state: self, next: &next, once: &sync.Once{}}
+}
+
+// Creates a channel where every value sent is received by every receiver, with many senders and many receivers.
+// The channel keeps the last capacity values. Sending never waits; a receiver that falls further behind than capacity values lags, and its next Recv returns an Err of RecvError with the number of values it skipped.
+// Panics if capacity is 0.
+//
+//	sender, first := gokio.BroadcastChannel[gost.I32](16)
+//	second := sender.Subscribe()
+//	sender.Send(1)
+//	gost.AssertEq(first.Recv().Await(), gost.Ok[gost.I32](1))
+//	gost.AssertEq(second.Recv().Await(), gost.Ok[gost.I32](1))
+func BroadcastChannel[T any](capacity gost.USize) (BroadcastSender[T], BroadcastReceiver[T]) {
+	if capacity == 0 {
+		panic("broadcast channel requires capacity > 0")
+	}
+
+	state := &_BroadcastState[T]{buffer: make([]T, capacity), senders: 1}
+
+	return BroadcastSender[T]{state: state, once: &sync.Once{}}, state.subscribe()
+}
+
+// The sending half of a broadcast channel, created by BroadcastChannel.
+type BroadcastSender[T any] struct {
+	state *_BroadcastState[T]
+	once  *sync.Once
+}
+
+// Sends a value to every receiver, without waiting, returning the number of receivers it was sent to.
+// Returns an Err of SendError, carrying the value back, if there is no receiver.
+func (self BroadcastSender[T]) Send(value T) gost.Result[gost.USize] {
+	self.state.lock.Lock()
+	defer self.state.lock.Unlock()
+
+	if self.state.receivers == 0 {
+		return gost.Err[gost.USize](SendError[T]{value: value})
+	}
+
+	self.state.buffer[self.state.head%gost.U64(len(self.state.buffer))] = value
+	self.state.head++
+	self.state.changed.notify()
+
+	return gost.Ok(self.state.receivers)
+}
+
+// Creates a new receiver, which receives every value sent from now on.
+func (self BroadcastSender[T]) Subscribe() BroadcastReceiver[T] {
+	return self.state.subscribe()
+}
+
+// Returns the number of receivers that are not closed.
+func (self BroadcastSender[T]) ReceiverCount() gost.USize {
+	self.state.lock.Lock()
+	defer self.state.lock.Unlock()
+
+	return self.state.receivers
+}
+
+// Closes this sender. Once every sender is closed, receivers get an Err of RecvError after the values they have not received yet.
+// Closing a sender twice does nothing.
+func (self BroadcastSender[T]) Close() {
+	self.once.Do(func() {
+		self.state.lock.Lock()
+		defer self.state.lock.Unlock()
+
+		self.state.senders--
+		if self.state.senders == 0 {
+			self.state.changed.notify()
+		}
+	})
+}
+
+// impl Clone for BroadcastSender
+// Returns another sender for the same channel, which must be closed on its own.
+func (self BroadcastSender[T]) Clone() BroadcastSender[T] {
+	self.state.lock.Lock()
+	defer self.state.lock.Unlock()
+
+	self.state.senders++
+
+	return BroadcastSender[T]{state: self.state, once: &sync.Once{}}
+}
+
+// The receiving half of a broadcast channel, created by BroadcastChannel or BroadcastSender.Subscribe.
+type BroadcastReceiver[T any] struct {
+	state *_BroadcastState[T]
+	next  *gost.U64
+	once  *sync.Once
+}
+
+// Receives the next value, waiting until one is sent.
+// Returns an Err of RecvError if the receiver lagged behind, in which case it skips to the oldest value still in the channel, or if every sender has been closed and no value is left.
+// When Timeout or Select discards the result of Recv, the receiver does not move past the value, so the next Recv gets it again.
+func (self BroadcastReceiver[T]) Recv() Future[gost.Result[T]] {
+	var previous, next gost.U64
+
+	attempt := func() gost.Option[gost.Result[T]] {
+		previous = *self.next
+
+		if oldest := self.state.oldest(); *self.next < oldest {
+			*self.next = oldest
+			next = oldest
+			return gost.Some(gost.Err[T](RecvError{lagged: oldest - previous}))
+		}
+
+		if *self.next < self.state.head {
+			value := self.state.buffer[*self.next%gost.U64(len(self.state.buffer))]
+			*self.next++
+			next = *self.next
+			return gost.Some(gost.Ok(value))
+		}
+
+		if self.state.senders == 0 {
+			next = previous
+			return gost.Some(gost.Err[T](RecvError{lagged: 0}))
+		}
+
+		return gost.None[gost.Result[T]]()
+	}
+
+	undo := func(gost.Result[T]) bool {
+		// Another Recv of this receiver may have moved on since.
+		if *self.next != next {
+			return false
+		}
+
+		*self.next = previous
+		return true
+	}
+
+	return _ChannelOp(&self.state.lock, &self.state.changed, attempt, undo)
+}
+
+// Returns the number of values sent that this receiver has not received yet, including any it will skip because it lagged.
+func (self BroadcastReceiver[T]) Len() gost.USize {
+	self.state.lock.Lock()
+	defer self.state.lock.Unlock()
+
+	return gost.USize(self.state.head - *self.next)
+}
+
+// Creates a new receiver for the same channel, which receives every value sent from now on.
+func (self BroadcastReceiver[T]) Resubscribe() BroadcastReceiver[T] {
+	return self.state.subscribe()
+}
+
+// Closes the receiver. Once every receiver is closed, sending fails. Closing a receiver twice does nothing.
+func (self BroadcastReceiver[T]) Close() {
+	self.once.Do(func() {
+		self.state.lock.Lock()
+		defer self.state.lock.Unlock()
+
+		self.state.receivers--
+	})
+}
@@ -0,0 +1,43 @@
+package gokio
+
+import "github.com/myyrakle/gost"
+
+// Waits until token is cancelled.
+//
+//	gokio.Select(gokio.Cancelled(token), gokio.Sleep(gost.DurationFromSecs(1))).Await()
+func Cancelled(token gost.CancellationToken) Future[any] {
+	return Spawn(func() any {
+		token.WaitCancelled()
+		return nil
+	})
+}
+
+// Waits on future until it completes or token is cancelled, whichever comes first.
+// Returns Some with the result of the future, or None if the token was cancelled first.
+// In that case, the future is cancelled if it supports it, like the acquire futures of Mutex and Semaphore or the Recv and Send futures of channels; otherwise it keeps running and its result is discarded.
+// To stop the work itself, pass the token into the task, and have it check the token or sleep with SleepCancellable.
+//
+//	token := gost.CancellationTokenNew()
+//	result := gokio.RunUntilCancelled(token, gokio.Spawn(func() gost.I32 {
+//		for !token.IsCancelled() {
+//			// ...
+//		}
+//		return 0
+//	}))
+func RunUntilCancelled[T any](token gost.CancellationToken, future gost.Future[T]) Future[gost.Option[T]] {
+	return Spawn(func() gost.Option[T] {
+		ch := _AwaitAsync(future)
+
+		select {
+		case result := <-ch:
+			return gost.Some(_Unwrap(result))
+		case <-token.Done():
+			if !_Cancel(future) {
+				// It completed just in time, and what it did cannot be undone.
+				return gost.Some(_Unwrap(<-ch))
+			}
+
+			return gost.None[T]()
+		}
+	})
+}
@@ -0,0 +1,30 @@
+package gokio
+
+import (
+	"testing"
+	"time"
+
+	"github.com/myyrakle/gost"
+)
+
+func Test_RunUntilCancelled(t *testing.T) {
+	t.Parallel()
+
+	token := gost.CancellationTokenNew()
+	gost.AssertEq(RunUntilCancelled(token, _After(0, gost.I32(1))).Await(), gost.Some[gost.I32](1), "the future completes first")
+
+	child := token.ChildToken()
+	pending := RunUntilCancelled(child, _After(1000, gost.I32(2)))
+	sleeping := SleepCancellable(gost.DurationFromSecs(10), child)
+	cancelled := Cancelled(child)
+
+	start := time.Now()
+	token.Cancel()
+	gost.AssertEq(pending.Await(), gost.None[gost.I32](), "the token is cancelled first")
+	gost.Assert(sleeping.Await().IsErr(), "SleepCancellable stops when the token is cancelled")
+	cancelled.Await()
+	gost.Assert(gost.Bool(time.Since(start) < 500*time.Millisecond), "cancellation is observed right away")
+
+	Sleep(gost.DurationFromMillis(1)).Await()
+	gost.Assert(SleepCancellable(gost.DurationFromMillis(1), gost.CancellationTokenNew()).Await().IsOk(), "SleepCancellable")
+}
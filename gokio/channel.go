@@ -0,0 +1,185 @@
+package gokio
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/myyrakle/gost"
+)
+
+// The error returned by Send when the other side of the channel has been closed. It carries the value that could not be sent.
+type SendError[T any] struct {
+	value T
+}
+
+// Returns the value that could not be sent.
+func (self SendError[T]) Value() T {
+	return self.value
+}
+
+// impl error for SendError
+func (self SendError[T]) Error() string {
+	return "channel closed"
+}
+
+// The error returned by TrySend when the value could not be sent right away. It carries the value that could not be sent.
+type TrySendError[T any] struct {
+	value  T
+	closed bool
+}
+
+// Returns the value that could not be sent.
+func (self TrySendError[T]) Value() T {
+	return self.value
+}
+
+// Returns true if the channel was full.
+func (self TrySendError[T]) IsFull() gost.Bool {
+	return gost.Bool(!self.closed)
+}
+
+// Returns true if the receiver was closed.
+func (self TrySendError[T]) IsClosed() gost.Bool {
+	return gost.Bool(self.closed)
+}
+
+// impl error for TrySendError
+func (self TrySendError[T]) Error() string {
+	if self.closed {
+		return "channel closed"
+	}
+
+	return "no available capacity"
+}
+
+// The error returned by TryRecv when no value could be received right away.
+type TryRecvError struct {
+	disconnected bool
+}
+
+// Returns true if the channel was empty, but its senders are still open.
+func (self TryRecvError) IsEmpty() gost.Bool {
+	return gost.Bool(!self.disconnected)
+}
+
+// Returns true if every sender was closed and no value is left.
+func (self TryRecvError) IsDisconnected() gost.Bool {
+	return gost.Bool(self.disconnected)
+}
+
+// impl error for TryRecvError
+func (self TryRecvError) Error() string {
+	if self.disconnected {
+		return "receiving on a closed channel"
+	}
+
+	return "receiving on an empty channel"
+}
+
+// The error of a receiver that could not receive a value, because the channel was closed or, for broadcast channels, because the receiver lagged behind.
+type RecvError struct {
+	lagged gost.U64
+}
+
+// Returns true if every sender was closed and no value is left.
+func (self RecvError) IsClosed() gost.Bool {
+	return self.lagged == 0
+}
+
+// Returns true if the receiver lagged behind, and the oldest values it had not received yet were overwritten.
+func (self RecvError) IsLagged() gost.Bool {
+	return self.lagged > 0
+}
+
+// Returns the number of values the receiver skipped because it lagged behind.
+func (self RecvError) Lagged() gost.U64 {
+	return self.lagged
+}
+
+// impl error for RecvError
+func (self RecvError) Error() string {
+	if self.lagged > 0 {
+		return fmt.Sprintf("channel lagged by %d", self.lagged)
+	}
+
+	return "channel closed"
+}
+
+// Wakes every goroutine waiting for the state of a channel to change.
+// Both wait and notify must be called with the lock of the channel held; the returned channel is waited on after releasing it.
+type _Changed struct {
+	ch chan struct{}
+}
+
+func (self *_Changed) wait() <-chan struct{} {
+	if self.ch == nil {
+		self.ch = make(chan struct{})
+	}
+
+	return self.ch
+}
+
+func (self *_Changed) notify() {
+	if self.ch != nil {
+		close(self.ch)
+		self.ch = nil
+	}
+}
+
+// Returns a future of an operation that changes a channel, like Recv or Send, which completes once attempt returns Some.
+// attempt is called with lock held, at first and then each time changed is notified.
+// When a combinator cancels the future, attempt is not called again, so the operation never happens. If it already happened, undo is called with lock held to revert it, and returns false if that is no longer possible.
+func _ChannelOp[T any](lock *sync.Mutex, changed *_Changed, attempt func() gost.Option[T], undo func(value T) bool) Future[T] {
+	future := &_WaitFuture[T]{ready: make(chan struct{}), cancelled: make(chan struct{})}
+
+	future.onCancel = func() bool {
+		lock.Lock()
+		defer lock.Unlock()
+
+		select {
+		case <-future.cancelled:
+			return true
+		case <-future.ready:
+			if !undo(future.value) {
+				return false
+			}
+		default:
+		}
+
+		close(future.cancelled)
+		return true
+	}
+
+	go func() {
+		lock.Lock()
+
+		for {
+			select {
+			case <-future.cancelled:
+				lock.Unlock()
+				return
+			default:
+			}
+
+			if value := attempt(); value.IsSome() {
+				future.value = value.Unwrap()
+				close(future.ready)
+				lock.Unlock()
+				return
+			}
+
+			wait := changed.wait()
+			lock.Unlock()
+
+			select {
+			case <-wait:
+			case <-future.cancelled:
+				return
+			}
+
+			lock.Lock()
+		}
+	}()
+
+	return future
+}
@@ -0,0 +1,186 @@
+package gokio
+
+import (
+	"testing"
+	"time"
+
+	"github.com/myyrakle/gost"
+)
+
+func Test_Mpsc(t *testing.T) {
+	t.Parallel()
+
+	sender, receiver := MpscChannel[gost.I32](2)
+	other := sender.Clone()
+
+	gost.Assert(sender.Send(1).Await().IsOk(), "Send")
+	gost.Assert(other.TrySend(2).IsOk(), "TrySend")
+
+	full := sender.TrySend(3)
+	gost.Assert(full.IsErr() && full.UnwrapErr().(TrySendError[gost.I32]).IsFull(), "TrySend on a full channel")
+
+	blocked := sender.Send(3)
+	time.Sleep(10 * time.Millisecond)
+	gost.Assert(!blocked.IsReady(), "Send waits for room")
+
+	gost.AssertEq(receiver.Recv().Await(), gost.Some[gost.I32](1), "Recv")
+	gost.Assert(blocked.Await().IsOk(), "Send completes once there is room")
+	gost.AssertEq(receiver.TryRecv(), gost.Ok[gost.I32](2), "TryRecv")
+	gost.AssertEq(receiver.Recv().Await(), gost.Some[gost.I32](3), "Recv")
+	gost.Assert(receiver.TryRecv().UnwrapErr().(TryRecvError).IsEmpty(), "TryRecv on an empty channel")
+
+	sender.Close()
+	sender.Close()
+	pending := receiver.Recv()
+	gost.Assert(other.Send(4).Await().IsOk(), "a clone keeps the channel open")
+	gost.AssertEq(pending.Await(), gost.Some[gost.I32](4), "Recv")
+
+	other.Close()
+	gost.AssertEq(receiver.Recv().Await(), gost.None[gost.I32](), "Recv after every sender is closed")
+
+	unbounded, unboundedReceiver := MpscUnboundedChannel[gost.String]()
+	for i := 0; i < 100; i++ {
+		gost.Assert(unbounded.Send("hello").IsOk(), "unbounded Send never waits")
+	}
+	gost.AssertEq(unboundedReceiver.Len(), gost.USize(100), "Len")
+
+	unboundedReceiver.Close()
+	gost.Assert(unbounded.IsClosed(), "IsClosed")
+	result := unbounded.Send("world")
+	gost.Assert(result.IsErr(), "Send after the receiver is closed")
+	gost.AssertEq(result.UnwrapErr().(SendError[gost.String]).Value(), gost.String("world"), "SendError carries the value")
+	gost.AssertEq(unboundedReceiver.Recv().Await(), gost.Some[gost.String]("hello"), "buffered values can still be received")
+}
+
+func Test_Oneshot(t *testing.T) {
+	t.Parallel()
+
+	sender, receiver := OneshotChannel[gost.I32]()
+	gost.Assert(receiver.TryRecv().UnwrapErr().(TryRecvError).IsEmpty(), "TryRecv before Send")
+
+	Spawn(func() gost.Result[any] { return sender.Send(7) })
+	gost.AssertEq(receiver.Recv().Await(), gost.Some[gost.I32](7), "Recv")
+	gost.Assert(sender.Send(8).IsErr(), "only one value can be sent")
+
+	sender, receiver = OneshotChannel[gost.I32]()
+	sender.Close()
+	gost.AssertEq(receiver.Recv().Await(), gost.None[gost.I32](), "Recv after the sender is closed")
+
+	sender, receiver = OneshotChannel[gost.I32]()
+	receiver.Close()
+	gost.Assert(sender.IsClosed(), "IsClosed")
+	gost.AssertEq(sender.Send(9).UnwrapErr().(SendError[gost.I32]).Value(), gost.I32(9), "SendError carries the value")
+}
+
+func Test_Broadcast(t *testing.T) {
+	t.Parallel()
+
+	sender, first := BroadcastChannel[gost.I32](2)
+	second := sender.Subscribe()
+	gost.AssertEq(sender.ReceiverCount(), gost.USize(2), "ReceiverCount")
+
+	gost.AssertEq(sender.Send(1), gost.Ok[gost.USize](2), "Send returns the number of receivers")
+	gost.AssertEq(first.Recv().Await(), gost.Ok[gost.I32](1), "every receiver gets the value")
+	gost.AssertEq(second.Recv().Await(), gost.Ok[gost.I32](1), "every receiver gets the value")
+
+	for i := gost.I32(2); i <= 5; i++ {
+		sender.Send(i)
+	}
+
+	gost.AssertEq(first.Len(), gost.USize(4), "Len")
+	lagged := first.Recv().Await()
+	gost.Assert(lagged.IsErr() && lagged.UnwrapErr().(RecvError).IsLagged(), "a slow receiver lags")
+	gost.AssertEq(lagged.UnwrapErr().(RecvError).Lagged(), gost.U64(2), "Lagged")
+	gost.AssertEq(first.Recv().Await(), gost.Ok[gost.I32](4), "a lagged receiver skips to the oldest value")
+	gost.AssertEq(first.Recv().Await(), gost.Ok[gost.I32](5), "Recv")
+
+	pending := first.Recv()
+	sender.Close()
+	gost.Assert(pending.Await().UnwrapErr().(RecvError).IsClosed(), "Recv after every sender is closed")
+
+	other, receiver := BroadcastChannel[gost.I32](1)
+	receiver.Close()
+	gost.AssertEq(other.Send(1).UnwrapErr().(SendError[gost.I32]).Value(), gost.I32(1), "Send without receivers")
+}
+
+func Test_Watch(t *testing.T) {
+	t.Parallel()
+
+	sender, receiver := WatchChannel(gost.String("starting"))
+	gost.AssertEq(receiver.Borrow(), gost.String("starting"), "Borrow")
+	gost.Assert(!receiver.HasChanged(), "the initial value is seen")
+
+	changed := receiver.Changed()
+	time.Sleep(10 * time.Millisecond)
+	gost.Assert(!changed.IsReady(), "Changed waits for a new value")
+
+	sender.Send("running")
+	sender.Send("stopping")
+	gost.Assert(changed.Await().IsOk(), "Changed")
+	gost.AssertEq(receiver.Borrow(), gost.String("stopping"), "receivers see the latest value")
+
+	clone := receiver.Clone()
+	sender.Send("stopped")
+	gost.AssertEq(clone.Recv().Await(), gost.Some[gost.String]("stopped"), "Recv")
+	gost.Assert(receiver.HasChanged(), "HasChanged")
+	gost.AssertEq(receiver.BorrowAndUpdate(), gost.String("stopped"), "BorrowAndUpdate")
+	gost.Assert(!receiver.HasChanged(), "BorrowAndUpdate marks the value as seen")
+
+	sender.Close()
+	gost.AssertEq(receiver.Recv().Await(), gost.None[gost.String](), "Recv after the sender is closed")
+	gost.Assert(clone.Changed().Await().IsErr(), "Changed after the sender is closed")
+
+	receiver.Close()
+	clone.Close()
+	other, last := WatchChannel(gost.I32(0))
+	last.Close()
+	gost.Assert(other.IsClosed(), "IsClosed")
+	gost.AssertEq(other.Send(1).UnwrapErr().(SendError[gost.I32]).Value(), gost.I32(1), "Send without receivers")
+}
+
+func Test_Channel_Cancel(t *testing.T) {
+	t.Parallel()
+
+	sender, receiver := MpscChannel[gost.I32](1)
+	timedOut := Timeout[gost.Option[gost.I32]](receiver.Recv(), gost.DurationFromMillis(10)).Await()
+	gost.Assert(timedOut.IsErr(), "Recv times out")
+	gost.Assert(sender.Send(1).Await().IsOk(), "Send")
+	time.Sleep(10 * time.Millisecond)
+	gost.AssertEq(receiver.Len(), gost.USize(1), "a timed out Recv takes nothing")
+	gost.AssertEq(receiver.TryRecv(), gost.Ok[gost.I32](1), "TryRecv")
+
+	gost.Assert(sender.TrySend(2).IsOk(), "TrySend")
+	gost.Assert(Timeout[gost.Result[any]](sender.Send(3), gost.DurationFromMillis(10)).Await().IsErr(), "Send times out on a full channel")
+	gost.AssertEq(receiver.TryRecv(), gost.Ok[gost.I32](2), "TryRecv")
+	time.Sleep(10 * time.Millisecond)
+	gost.Assert(receiver.TryRecv().IsErr(), "a timed out Send sends nothing")
+
+	otherSender, otherReceiver := MpscUnboundedChannel[gost.I32]()
+	for i := gost.I32(0); i < 50; i++ {
+		gost.Assert(sender.Send(i).Await().IsOk(), "Send")
+		gost.Assert(otherSender.Send(i).IsOk(), "Send")
+
+		first := Select[gost.Option[gost.I32]](receiver.Recv(), otherReceiver.Recv()).Await()
+		gost.AssertEq(first.Value, gost.Some(i), "Select receives the value")
+
+		// The value of the other receiver is put back, or was never taken.
+		if first.Key == 0 {
+			gost.AssertEq(otherReceiver.Recv().Await(), gost.Some(i), "Select loses nothing")
+		} else {
+			gost.AssertEq(receiver.Recv().Await(), gost.Some(i), "Select loses nothing")
+		}
+	}
+
+	broadcastSender, broadcastReceiver := BroadcastChannel[gost.I32](4)
+	gost.Assert(Timeout[gost.Result[gost.I32]](broadcastReceiver.Recv(), gost.DurationFromMillis(10)).Await().IsErr(), "broadcast Recv times out")
+	broadcastSender.Send(4)
+	time.Sleep(10 * time.Millisecond)
+	gost.AssertEq(broadcastReceiver.Recv().Await(), gost.Ok[gost.I32](4), "a timed out broadcast Recv skips nothing")
+
+	watchSender, watchReceiver := WatchChannel[gost.I32](0)
+	gost.Assert(Timeout[gost.Result[any]](watchReceiver.Changed(), gost.DurationFromMillis(10)).Await().IsErr(), "Changed times out")
+	watchSender.Send(5)
+	time.Sleep(10 * time.Millisecond)
+	gost.Assert(watchReceiver.HasChanged(), "a timed out Changed marks nothing as seen")
+	gost.AssertEq(watchReceiver.Recv().Await(), gost.Some[gost.I32](5), "watch Recv")
+}
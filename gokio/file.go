@@ -0,0 +1,293 @@
+package gokio
+
+import (
+	"sync"
+
+	"github.com/myyrakle/gost"
+)
+
+// The size of the chunks moved by CopyStream and Copy.
+const _COPY_CHUNK_SIZE = 64 * 1024
+
+// The number of chunks that can wait between the reader and the writer of a copy, and the lines that can wait in a LineStream.
+// A producer that gets this far ahead blocks until the consumer catches up.
+const _STREAM_BUFFER = 4
+
+// An asynchronous sequence of values. Each call to Next returns a future of the next value, or None once the stream has ended.
+type Stream[T any] interface {
+	Next() Future[gost.Option[T]]
+}
+
+// A file that is read and written through futures, a chunk at a time.
+// Operations on one AsyncFile are serialized; await each future before starting the next one, or they may run in any order.
+//
+//	file := gokio.FileOpen("large.bin").Await().Unwrap()
+//	defer file.Close().Await()
+//
+//	for {
+//		chunk := file.ReadChunk(64 * 1024).Await().Unwrap()
+//		if len(chunk) == 0 {
+//			break
+//		}
+//	}
+type AsyncFile struct {
+	file gost.File
+	lock *sync.Mutex
+}
+
+// Wraps an open gost.File.
+func AsyncFileFrom(file gost.File) AsyncFile {
+	return AsyncFile{file: file, lock: &sync.Mutex{}}
+}
+
+// Attempts to open a file in read-only mode.
+func FileOpen[P gost.AsPath](path P) Future[gost.Result[AsyncFile]] {
+	return FileOpenWith(gost.OpenOptionsNew().Read(true), path)
+}
+
+// Opens a file in write-only mode, creating it if it does not exist and truncating it if it does.
+func FileCreate[P gost.AsPath](path P) Future[gost.Result[AsyncFile]] {
+	return FileOpenWith(gost.OpenOptionsNew().Write(true).Create(true).Truncate(true), path)
+}
+
+// Opens a file at path with the given options.
+func FileOpenWith[P gost.AsPath](options gost.OpenOptions, path P) Future[gost.Result[AsyncFile]] {
+	return Spawn(func() gost.Result[AsyncFile] {
+		file := gost.OpenOptionsOpen(options, path)
+
+		if file.IsErr() {
+			return gost.Err[AsyncFile](file.UnwrapErr())
+		} else {
+			return gost.Ok(AsyncFileFrom(file.Unwrap()))
+		}
+	})
+}
+
+// Returns the underlying gost.File.
+func (self AsyncFile) File() gost.File {
+	return self.file
+}
+
+// Reads up to size bytes from the current position. An empty chunk means the end of the file has been reached.
+func (self AsyncFile) ReadChunk(size gost.USize) Future[gost.Result[[]gost.Byte]] {
+	return Spawn(func() gost.Result[[]gost.Byte] {
+		self.lock.Lock()
+		defer self.lock.Unlock()
+
+		buffer := make([]gost.Byte, size)
+		result := self.file.Read(buffer)
+
+		if result.IsErr() {
+			return gost.Err[[]gost.Byte](result.UnwrapErr())
+		} else {
+			return gost.Ok(buffer[:result.Unwrap()])
+		}
+	})
+}
+
+// Writes the entire buffer at the current position.
+func (self AsyncFile) WriteAll(buffer []gost.Byte) Future[gost.Result[any]] {
+	return Spawn(func() gost.Result[any] {
+		self.lock.Lock()
+		defer self.lock.Unlock()
+
+		return self.file.WriteAll(buffer)
+	})
+}
+
+// Seeks to an offset, in bytes, returning the new position from the start of the file.
+func (self AsyncFile) Seek(position gost.SeekFrom) Future[gost.Result[gost.U64]] {
+	return Spawn(func() gost.Result[gost.U64] {
+		self.lock.Lock()
+		defer self.lock.Unlock()
+
+		return self.file.Seek(position)
+	})
+}
+
+// Attempts to sync all OS-internal file content and metadata to disk.
+func (self AsyncFile) SyncAll() Future[gost.Result[any]] {
+	return Spawn(func() gost.Result[any] {
+		self.lock.Lock()
+		defer self.lock.Unlock()
+
+		return self.file.SyncAll()
+	})
+}
+
+// Queries metadata about the underlying file.
+func (self AsyncFile) Metadata() Future[gost.Result[gost.Metadata]] {
+	return Spawn(func() gost.Result[gost.Metadata] {
+		self.lock.Lock()
+		defer self.lock.Unlock()
+
+		return self.file.Metadata()
+	})
+}
+
+// Closes the file, after any running operation has finished.
+func (self AsyncFile) Close() Future[gost.Result[any]] {
+	return Spawn(func() gost.Result[any] {
+		self.lock.Lock()
+		defer self.lock.Unlock()
+
+		return self.file.Close()
+	})
+}
+
+// Returns a stream over the lines of the file, from the current position. See Lines.
+// The stream reads the file in the background, so no other operation should run on the file until the stream is closed or ends.
+func (self AsyncFile) Lines() *LineStream {
+	return Lines[gost.File](self.file)
+}
+
+// A stream over the lines of a reader, created by Lines and AsyncFile.Lines.
+type LineStream struct {
+	lines chan gost.Result[gost.String]
+	done  chan struct{}
+	once  *sync.Once
+}
+
+// Returns a stream over the lines of reader, without their newline.
+// Lines are read in the background and buffered, up to a small limit, until Next is awaited.
+// Close the stream to stop reading early.
+//
+//	lines := gokio.Lines(gost.Stdin{})
+//	for line := lines.Next().Await(); line.IsSome(); line = lines.Next().Await() {
+//		gost.Println("{}", line.Unwrap().Unwrap())
+//	}
+func Lines[R gost.Reader](reader R) *LineStream {
+	stream := &LineStream{
+		lines: make(chan gost.Result[gost.String], _STREAM_BUFFER),
+		done:  make(chan struct{}),
+		once:  &sync.Once{},
+	}
+
+	go func() {
+		defer close(stream.lines)
+
+		buffered := gost.BufReaderNew(reader)
+		iter := buffered.Lines()
+
+		for line := iter.Next(); line.IsSome(); line = iter.Next() {
+			select {
+			case stream.lines <- line.Unwrap():
+			case <-stream.done:
+				return
+			}
+
+			if line.Unwrap().IsErr() {
+				return
+			}
+		}
+	}()
+
+	return stream
+}
+
+// impl Stream for LineStream
+// An error reading a line is yielded once, after which the stream ends.
+func (self *LineStream) Next() Future[gost.Option[gost.Result[gost.String]]] {
+	return Spawn(func() gost.Option[gost.Result[gost.String]] {
+		// Checked first, since a closed stream may still have lines buffered.
+		select {
+		case <-self.done:
+			return gost.None[gost.Result[gost.String]]()
+		default:
+		}
+
+		select {
+		case line, ok := <-self.lines:
+			if ok {
+				return gost.Some(line)
+			}
+		case <-self.done:
+		}
+
+		return gost.None[gost.Result[gost.String]]()
+	})
+}
+
+// Stops reading lines. Lines that were already read are dropped, and Next returns None from now on.
+func (self *LineStream) Close() {
+	self.once.Do(func() { close(self.done) })
+}
+
+// Copies everything from reader to writer, then flushes writer, returning the number of bytes copied.
+// Chunks are read and written concurrently, and the reader waits when the writer falls behind by a few chunks, so memory use stays bounded however much is copied.
+//
+//	source := gost.FileOpen("large.bin").Unwrap()
+//	destination := gost.FileCreate("copy.bin").Unwrap()
+//	copied := gokio.CopyStream(source, destination).Await().Unwrap()
+func CopyStream[R gost.Reader, W gost.Writer](reader R, writer W) Future[gost.Result[gost.U64]] {
+	return Spawn(func() gost.Result[gost.U64] {
+		return _Pipe[R, W](reader, writer)
+	})
+}
+
+func _Pipe[R gost.Reader, W gost.Writer](reader R, writer W) gost.Result[gost.U64] {
+	// Buffers go around in a loop, from free to filled and back, so at most _STREAM_BUFFER chunks exist.
+	free := make(chan []gost.Byte, _STREAM_BUFFER)
+	for i := 0; i < _STREAM_BUFFER; i++ {
+		free <- make([]gost.Byte, _COPY_CHUNK_SIZE)
+	}
+
+	filled := make(chan []gost.Byte, _STREAM_BUFFER)
+	done := make(chan struct{})
+	readErr := make(chan error, 1)
+
+	go func() {
+		defer close(filled)
+
+		for {
+			var buffer []gost.Byte
+
+			select {
+			case buffer = <-free:
+			case <-done:
+				return
+			}
+
+			result := reader.Read(buffer)
+
+			if result.IsErr() {
+				readErr <- result.UnwrapErr()
+				return
+			}
+
+			if result.Unwrap() == 0 {
+				return
+			}
+
+			select {
+			case filled <- buffer[:result.Unwrap()]:
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	written := gost.U64(0)
+
+	for chunk := range filled {
+		if result := gost.WriteAll(writer, chunk); result.IsErr() {
+			close(done)
+			return gost.Err[gost.U64](result.UnwrapErr())
+		}
+
+		written += gost.U64(len(chunk))
+		free <- chunk[:cap(chunk)]
+	}
+
+	select {
+	case err := <-readErr:
+		return gost.Err[gost.U64](err)
+	default:
+	}
+
+	if result := writer.Flush(); result.IsErr() {
+		return gost.Err[gost.U64](result.UnwrapErr())
+	}
+
+	return gost.Ok(written)
+}
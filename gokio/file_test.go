@@ -0,0 +1,101 @@
+package gokio
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/myyrakle/gost"
+)
+
+func Test_AsyncFile(t *testing.T) {
+	t.Parallel()
+
+	path := gost.Path(t.TempDir()).Join("file.txt")
+
+	file := FileCreate(path).Await().Unwrap()
+	gost.Assert(file.WriteAll([]gost.Byte("first\nsecond\nthird")).Await().IsOk(), "WriteAll")
+	gost.Assert(file.Close().Await().IsOk(), "Close")
+
+	file = FileOpen(path).Await().Unwrap()
+	gost.AssertEq(gost.String(file.ReadChunk(5).Await().Unwrap()), gost.String("first"), "ReadChunk")
+	gost.AssertEq(file.Seek(gost.SeekFromStart(0)).Await(), gost.Ok[gost.U64](0), "Seek")
+
+	lines := file.Lines()
+	expected := []gost.String{"first", "second", "third"}
+
+	for _, want := range expected {
+		gost.AssertEq(lines.Next().Await(), gost.Some(gost.Ok(want)), "Lines")
+	}
+	gost.Assert(lines.Next().Await().IsNone(), "Lines ends")
+
+	gost.Assert(file.Close().Await().IsOk(), "Close")
+
+	closed := Lines(gost.IoReader(strings.NewReader("a\nb\nc\n")))
+	time.Sleep(10 * time.Millisecond)
+	closed.Close()
+	for i := 0; i < 20; i++ {
+		gost.Assert(closed.Next().Await().IsNone(), "Next returns None after Close, even with lines buffered")
+	}
+}
+
+func Test_Copy(t *testing.T) {
+	t.Parallel()
+
+	dir := gost.Path(t.TempDir())
+	data := make([]gost.Byte, _COPY_CHUNK_SIZE*3+10)
+	for i := range data {
+		data[i] = gost.Byte(i % 251)
+	}
+	gost.Assert(gost.Write(dir.Join("from"), data).IsOk(), "Write")
+	gost.Assert(gost.SetPermissions(dir.Join("from"), gost.PermissionsFromMode(0o640)).IsOk(), "SetPermissions")
+
+	gost.Assert(Copy(dir.Join("from"), dir.Join("to")).Await().IsOk(), "Copy")
+
+	copied := gost.Read(dir.Join("to")).Unwrap()
+	gost.AssertEq(gost.USize(len(copied)), gost.USize(len(data)), "Copy copies every chunk")
+	gost.AssertEq(gost.String(copied), gost.String(data), "Copy keeps the order of chunks")
+	gost.AssertEq(gost.MetadataOf(dir.Join("to")).Unwrap().Permissions().Mode(), gost.U32(0o640), "Copy copies the permissions")
+
+	gost.Assert(Copy(filepath.Join(string(dir), "missing"), dir.Join("other")).Await().IsErr(), "Copy from a missing file")
+}
+
+type _EndlessReader struct {
+	reads *atomic.Int64
+}
+
+func (self _EndlessReader) Read(buffer []gost.Byte) gost.Result[gost.USize] {
+	self.reads.Add(1)
+	return gost.Ok(gost.USize(len(buffer)))
+}
+
+type _BlockedWriter struct {
+	release chan struct{}
+}
+
+func (self _BlockedWriter) Write(buffer []gost.Byte) gost.Result[gost.USize] {
+	<-self.release
+	return gost.Err[gost.USize](errors.New("closed"))
+}
+
+func (self _BlockedWriter) Flush() gost.Result[any] {
+	return gost.Ok[any](nil)
+}
+
+func Test_CopyStream_Backpressure(t *testing.T) {
+	t.Parallel()
+
+	reads := &atomic.Int64{}
+	writer := _BlockedWriter{release: make(chan struct{})}
+
+	future := CopyStream(_EndlessReader{reads: reads}, writer)
+	time.Sleep(50 * time.Millisecond)
+
+	gost.Assert(gost.Bool(reads.Load() <= _STREAM_BUFFER), "the reader waits for the writer")
+
+	close(writer.release)
+	gost.Assert(future.Await().IsErr(), "a write error stops the copy")
+}
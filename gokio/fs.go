@@ -5,28 +5,28 @@ import (
 )
 
 // Creates a new, empty directory at the provided path
-func CreateDir(path gost.String) gost.Future[gost.Result[any]] {
+func CreateDir[P gost.AsPath](path P) Future[gost.Result[any]] {
 	return Spawn(func() gost.Result[any] {
 		return gost.CreateDir(path)
 	})
 }
 
 // Removes an empty directory.
-func RemoveDir(path gost.String) gost.Future[gost.Result[any]] {
+func RemoveDir[P gost.AsPath](path P) Future[gost.Result[any]] {
 	return Spawn(func() gost.Result[any] {
 		return gost.RemoveDir(path)
 	})
 }
 
 // Write a slice as the entire contents of a file.
-func Write(path gost.String, data []gost.Byte) gost.Future[gost.Result[any]] {
+func Write[P gost.AsPath](path P, data []gost.Byte) Future[gost.Result[any]] {
 	return Spawn(func() gost.Result[any] {
 		return gost.Write(path, data)
 	})
 }
 
 // Removes a file from the filesystem.
-func RemoveFile(path gost.String) gost.Future[gost.Result[any]] {
+func RemoveFile[P gost.AsPath](path P) Future[gost.Result[any]] {
 	return Spawn(func() gost.Result[any] {
 		return gost.RemoveFile(path)
 	})
@@ -34,21 +34,21 @@ func RemoveFile(path gost.String) gost.Future[gost.Result[any]] {
 
 // Rename a file or directory to a new name, replacing the original file if to already exists.
 // This will not work if the new name is on a different mount point.
-func Rename(from gost.String, to gost.String) gost.Future[gost.Result[any]] {
+func Rename[F gost.AsPath, T gost.AsPath](from F, to T) Future[gost.Result[any]] {
 	return Spawn(func() gost.Result[any] {
 		return gost.Rename(from, to)
 	})
 }
 
 // Read the entire contents of a file into a bytes vector.
-func Read(path gost.String) gost.Future[gost.Result[[]gost.Byte]] {
+func Read[P gost.AsPath](path P) Future[gost.Result[[]gost.Byte]] {
 	return Spawn(func() gost.Result[[]gost.Byte] {
 		return gost.Read(path)
 	})
 }
 
 // Returns an iterator over the entries within a directory.
-func ReadDir(path gost.String) gost.Future[gost.Result[gost.Vec[gost.DirEntry]]] {
+func ReadDir[P gost.AsPath](path P) Future[gost.Result[gost.Vec[gost.DirEntry]]] {
 	return Spawn(func() gost.Result[gost.Vec[gost.DirEntry]] {
 		return gost.ReadDir(path)
 	})
@@ -56,15 +56,101 @@ func ReadDir(path gost.String) gost.Future[gost.Result[gost.Vec[gost.DirEntry]]]
 
 // Copies the contents of one file to another. This function will also copy the permission bits of the original file to the destination file.
 // This function will overwrite the contents of to.
-func Copy(from gost.String, to gost.String) gost.Future[gost.Result[any]] {
+// The contents are streamed with CopyStream, so only a few chunks of the file are in memory at once.
+func Copy[F gost.AsPath, T gost.AsPath](from F, to T) Future[gost.Result[any]] {
 	return Spawn(func() gost.Result[any] {
-		return gost.Copy(from, to)
+		source := gost.FileOpen(from)
+		if source.IsErr() {
+			return gost.Err[any](source.UnwrapErr())
+		}
+		defer source.Unwrap().Close()
+
+		metadata := source.Unwrap().Metadata()
+		if metadata.IsErr() {
+			return gost.Err[any](metadata.UnwrapErr())
+		}
+		permissions := metadata.Unwrap().Permissions()
+
+		destination := gost.OpenOptionsOpen(gost.OpenOptionsNew().Write(true).Create(true).Truncate(true).Mode(permissions.Mode()), to)
+		if destination.IsErr() {
+			return gost.Err[any](destination.UnwrapErr())
+		}
+
+		copied := _Pipe(source.Unwrap(), destination.Unwrap())
+		closed := destination.Unwrap().Close()
+
+		if copied.IsErr() {
+			return gost.Err[any](copied.UnwrapErr())
+		}
+
+		if closed.IsErr() {
+			return closed
+		}
+
+		// The mode given to Open only applies to new files, and is subject to the umask.
+		return gost.SetPermissions(to, permissions)
 	})
 }
 
 // Read the entire contents of a file into a string.
-func ReadToString(path gost.String) gost.Future[gost.Result[gost.String]] {
+func ReadToString[P gost.AsPath](path P) Future[gost.Result[gost.String]] {
 	return Spawn(func() gost.Result[gost.String] {
 		return gost.ReadToString(path)
 	})
 }
+
+// Recursively create a directory and all of its parent components if they are missing.
+func CreateDirAll[P gost.AsPath](path P) Future[gost.Result[any]] {
+	return Spawn(func() gost.Result[any] {
+		return gost.CreateDirAll(path)
+	})
+}
+
+// Removes a directory at this path, after removing all its contents.
+func RemoveDirAll[P gost.AsPath](path P) Future[gost.Result[any]] {
+	return Spawn(func() gost.Result[any] {
+		return gost.RemoveDirAll(path)
+	})
+}
+
+// Given a path, queries the file system to get information about a file, directory, etc.
+func MetadataOf[P gost.AsPath](path P) Future[gost.Result[gost.Metadata]] {
+	return Spawn(func() gost.Result[gost.Metadata] {
+		return gost.MetadataOf(path)
+	})
+}
+
+// Queries the metadata about a file without following symlinks.
+func SymlinkMetadata[P gost.AsPath](path P) Future[gost.Result[gost.Metadata]] {
+	return Spawn(func() gost.Result[gost.Metadata] {
+		return gost.SymlinkMetadata(path)
+	})
+}
+
+// Returns the canonical, absolute form of a path with all intermediate components normalized and symbolic links resolved.
+func Canonicalize[P gost.AsPath](path P) Future[gost.Result[gost.PathBuf]] {
+	return Spawn(func() gost.Result[gost.PathBuf] {
+		return gost.Canonicalize(path)
+	})
+}
+
+// Reads a symbolic link, returning the file that the link points to.
+func ReadLink[P gost.AsPath](path P) Future[gost.Result[gost.PathBuf]] {
+	return Spawn(func() gost.Result[gost.PathBuf] {
+		return gost.ReadLink(path)
+	})
+}
+
+// Creates a new symbolic link on the filesystem.
+func Symlink[O gost.AsPath, L gost.AsPath](original O, link L) Future[gost.Result[any]] {
+	return Spawn(func() gost.Result[any] {
+		return gost.Symlink(original, link)
+	})
+}
+
+// Creates a new hard link on the filesystem.
+func HardLink[O gost.AsPath, L gost.AsPath](original O, link L) Future[gost.Result[any]] {
+	return Spawn(func() gost.Result[any] {
+		return gost.HardLink(original, link)
+	})
+}
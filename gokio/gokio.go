@@ -2,23 +2,138 @@ package gokio
 
 import "github.com/myyrakle/gost"
 
+// A future returned by gokio. Besides Await, it can be awaited with TryAwait, which reports a panic of the task as an Err instead of propagating it.
+// A gokio future keeps its result once it completes, so it can be awaited any number of times, from any number of goroutines.
+type Future[T any] interface {
+	gost.Future[T]
+	TryAwait() gost.Result[T]
+	Poll() gost.Option[T]
+	IsReady() gost.Bool
+}
+
 type gokioFuture[T any] struct {
-	ch chan T
+	done   chan struct{}
+	result gost.Result[T]
 }
 
+// Waits for the task and returns its result. If the task panicked, the panic is propagated to the caller as a gost.PanicError.
 func (self *gokioFuture[T]) Await() T {
-	return <-self.ch
+	result := self.TryAwait()
+
+	if result.IsErr() {
+		panic(result.UnwrapErr())
+	}
+
+	return result.Unwrap()
 }
 
-func Spawn[T any](f func() T) gost.Future[T] {
-	ch := make(chan T)
+// Waits for the task and returns its result, or an Err of gost.PanicError with the panic payload and stack if the task panicked.
+func (self *gokioFuture[T]) TryAwait() gost.Result[T] {
+	<-self.done
+	return self.result
+}
 
-	future := gokioFuture[T]{ch: ch}
+// Returns the result of the task without waiting, or None if it has not completed yet.
+// If the task panicked, the panic is propagated to the caller as a gost.PanicError, as with Await.
+func (self *gokioFuture[T]) Poll() gost.Option[T] {
+	if !self.IsReady() {
+		return gost.None[T]()
+	}
+
+	return gost.Some(self.Await())
+}
+
+// Checks if the task has completed, without waiting.
+func (self *gokioFuture[T]) IsReady() gost.Bool {
+	select {
+	case <-self.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// Runs f in a new goroutine, returning a future of its result.
+// A panic in f does not crash the process; it is reported by the future.
+//
+//	future := gokio.Spawn(func() gost.I32 { panic("oh no") })
+//	gost.Assert(future.TryAwait().IsErr())
+func Spawn[T any](f func() T) Future[T] {
+	future := &gokioFuture[T]{done: make(chan struct{})}
 
 	go func() {
-		defer close(ch)
-		ch <- f()
+		future.result = gost.CatchUnwind(f)
+		close(future.done)
 	}()
 
-	return &future
+	return future
+}
+
+// Returns a future that has already completed with value.
+//
+//	future := gokio.Ready(gost.I32(42))
+//	gost.Assert(future.IsReady())
+func Ready[T any](value T) Future[T] {
+	future := &gokioFuture[T]{done: make(chan struct{}), result: gost.Ok(value)}
+	close(future.done)
+
+	return future
+}
+
+// A future that can be awaited by any number of consumers, created by SharedFrom.
+// The wrapped future is awaited once, in the background, and every consumer gets its result. Copies of a Shared share the same result.
+//
+//	config := gokio.SharedFrom(gokio.ReadToString("config.toml"))
+//	for i := 0; i < 4; i++ {
+//		gokio.Spawn(func() any { return config.Await() })
+//	}
+type Shared[T any] struct {
+	future *gokioFuture[T]
+}
+
+// Wraps future so that it can be awaited by any number of consumers.
+// If future panics, or is a gokio Future whose task panicked, every consumer gets the panic.
+func SharedFrom[T any](future gost.Future[T]) Shared[T] {
+	if shared, ok := future.(Shared[T]); ok {
+		return shared
+	}
+
+	inner := &gokioFuture[T]{done: make(chan struct{})}
+
+	go func() {
+		if tryFuture, ok := future.(Future[T]); ok {
+			inner.result = tryFuture.TryAwait()
+		} else {
+			inner.result = gost.CatchUnwind(future.Await)
+		}
+
+		close(inner.done)
+	}()
+
+	return Shared[T]{future: inner}
+}
+
+// impl Future for Shared
+func (self Shared[T]) Await() T {
+	return self.future.Await()
+}
+
+// impl Future for Shared
+func (self Shared[T]) TryAwait() gost.Result[T] {
+	return self.future.TryAwait()
+}
+
+// impl Future for Shared
+func (self Shared[T]) Poll() gost.Option[T] {
+	return self.future.Poll()
+}
+
+// impl Future for Shared
+func (self Shared[T]) IsReady() gost.Bool {
+	return self.future.IsReady()
+}
+
+// impl Clone for Shared
+func (self Shared[T]) Clone() Shared[T] {
+	return self
 }
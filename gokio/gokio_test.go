@@ -0,0 +1,72 @@
+package gokio
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/myyrakle/gost"
+)
+
+type _CountedFuture struct {
+	count *atomic.Int64
+}
+
+func (self _CountedFuture) Await() gost.I32 {
+	self.count.Add(1)
+	time.Sleep(10 * time.Millisecond)
+	return 7
+}
+
+func Test_Future_AwaitTwice(t *testing.T) {
+	t.Parallel()
+
+	future := Spawn(func() gost.I32 { return 42 })
+	gost.AssertEq(future.Await(), gost.I32(42), "first Await")
+	gost.AssertEq(future.Await(), gost.I32(42), "second Await")
+	gost.AssertEq(future.TryAwait(), gost.Ok[gost.I32](42), "TryAwait after Await")
+}
+
+func Test_Future_Poll(t *testing.T) {
+	t.Parallel()
+
+	release := make(chan struct{})
+	future := Spawn(func() gost.I32 { <-release; return 1 })
+	gost.Assert(!future.IsReady(), "IsReady before completion")
+	gost.AssertEq(future.Poll(), gost.None[gost.I32](), "Poll before completion")
+
+	close(release)
+	future.Await()
+	gost.Assert(future.IsReady(), "IsReady after completion")
+	gost.AssertEq(future.Poll(), gost.Some[gost.I32](1), "Poll after completion")
+
+	ready := Ready(gost.String("done"))
+	gost.Assert(ready.IsReady(), "Ready is ready")
+	gost.AssertEq(ready.Await(), gost.String("done"), "Ready")
+}
+
+func Test_Shared(t *testing.T) {
+	t.Parallel()
+
+	count := &atomic.Int64{}
+	shared := SharedFrom[gost.I32](_CountedFuture{count: count})
+	gost.AssertEq(SharedFrom[gost.I32](shared).Clone().Await(), gost.I32(7), "SharedFrom of a Shared")
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(consumer Shared[gost.I32]) {
+			defer wg.Done()
+			gost.AssertEq(consumer.Await(), gost.I32(7), "every consumer gets the value")
+		}(shared.Clone())
+	}
+	wg.Wait()
+
+	gost.AssertEq(shared.Poll(), gost.Some[gost.I32](7), "Poll")
+	gost.Assert(gost.Bool(count.Load() == 1), "the inner future is awaited once")
+
+	panicked := SharedFrom[gost.I32](Spawn(func() gost.I32 { panic("oh no") }))
+	gost.Assert(panicked.TryAwait().IsErr(), "every consumer gets the panic")
+	gost.Assert(panicked.TryAwait().IsErr(), "every consumer gets the panic")
+}
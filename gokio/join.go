@@ -0,0 +1,245 @@
+package gokio
+
+import (
+	"time"
+
+	"github.com/myyrakle/gost"
+)
+
+// The results of three futures, returned by Join3.
+type Tuple3[A any, B any, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+// The results of four futures, returned by Join4.
+type Tuple4[A any, B any, C any, D any] struct {
+	First  A
+	Second B
+	Third  C
+	Fourth D
+}
+
+// The results of five futures, returned by Join5.
+type Tuple5[A any, B any, C any, D any, E any] struct {
+	First  A
+	Second B
+	Third  C
+	Fourth D
+	Fifth  E
+}
+
+// A future that can give up its result, implemented by the futures of the synchronization primitives and channels.
+// Combinators cancel the futures whose results they discard, so an abandoned future never holds a lock or a permit, or takes or sends a value.
+// cancel returns false if the future already completed and what it did cannot be undone, like a value sent and already received.
+type _Cancellable interface {
+	cancel() bool
+}
+
+// Cancels future if it supports it. Returns false if the future completed and could not be cancelled, so its result still stands.
+func _Cancel[T any](future gost.Future[T]) bool {
+	if cancellable, ok := future.(_Cancellable); ok {
+		return cancellable.cancel()
+	}
+
+	return true
+}
+
+// Awaits a future, returning an Err instead of propagating a panic.
+func _TryAwait[T any](future gost.Future[T]) gost.Result[T] {
+	if tryFuture, ok := future.(Future[T]); ok {
+		return tryFuture.TryAwait()
+	}
+
+	return gost.CatchUnwind(future.Await)
+}
+
+// Awaits a future in the background, returning a channel that receives its result.
+func _AwaitAsync[T any](future gost.Future[T]) <-chan gost.Result[T] {
+	ch := make(chan gost.Result[T], 1)
+
+	go func() {
+		ch <- _TryAwait(future)
+	}()
+
+	return ch
+}
+
+// Returns the value of a result received from _AwaitAsync, propagating the panic of the future it came from.
+func _Unwrap[T any](result gost.Result[T]) T {
+	if result.IsErr() {
+		panic(result.UnwrapErr())
+	}
+
+	return result.Unwrap()
+}
+
+// Waits on two futures concurrently, returning both results once both have completed.
+//
+//	pair := gokio.Join2(gokio.Read("a.txt"), gokio.Read("b.txt")).Await()
+func Join2[A any, B any](a gost.Future[A], b gost.Future[B]) Future[gost.Pair[A, B]] {
+	return Spawn(func() gost.Pair[A, B] {
+		chA, chB := _AwaitAsync(a), _AwaitAsync(b)
+		return gost.Pair[A, B]{Key: _Unwrap(<-chA), Value: _Unwrap(<-chB)}
+	})
+}
+
+// Waits on three futures concurrently, returning all results once all have completed.
+func Join3[A any, B any, C any](a gost.Future[A], b gost.Future[B], c gost.Future[C]) Future[Tuple3[A, B, C]] {
+	return Spawn(func() Tuple3[A, B, C] {
+		chA, chB, chC := _AwaitAsync(a), _AwaitAsync(b), _AwaitAsync(c)
+		return Tuple3[A, B, C]{First: _Unwrap(<-chA), Second: _Unwrap(<-chB), Third: _Unwrap(<-chC)}
+	})
+}
+
+// Waits on four futures concurrently, returning all results once all have completed.
+func Join4[A any, B any, C any, D any](a gost.Future[A], b gost.Future[B], c gost.Future[C], d gost.Future[D]) Future[Tuple4[A, B, C, D]] {
+	return Spawn(func() Tuple4[A, B, C, D] {
+		chA, chB, chC, chD := _AwaitAsync(a), _AwaitAsync(b), _AwaitAsync(c), _AwaitAsync(d)
+		return Tuple4[A, B, C, D]{First: _Unwrap(<-chA), Second: _Unwrap(<-chB), Third: _Unwrap(<-chC), Fourth: _Unwrap(<-chD)}
+	})
+}
+
+// Waits on five futures concurrently, returning all results once all have completed.
+// To wait on more futures of one type, use JoinAll.
+func Join5[A any, B any, C any, D any, E any](a gost.Future[A], b gost.Future[B], c gost.Future[C], d gost.Future[D], e gost.Future[E]) Future[Tuple5[A, B, C, D, E]] {
+	return Spawn(func() Tuple5[A, B, C, D, E] {
+		chA, chB, chC, chD, chE := _AwaitAsync(a), _AwaitAsync(b), _AwaitAsync(c), _AwaitAsync(d), _AwaitAsync(e)
+		return Tuple5[A, B, C, D, E]{First: _Unwrap(<-chA), Second: _Unwrap(<-chB), Third: _Unwrap(<-chC), Fourth: _Unwrap(<-chD), Fifth: _Unwrap(<-chE)}
+	})
+}
+
+// Waits on every future concurrently, returning their results in the same order once all have completed.
+//
+//	futures := gost.VecNew[gost.Future[gost.Result[gost.String]]]()
+//	futures.Push(gokio.ReadToString("a.txt"))
+//	futures.Push(gokio.ReadToString("b.txt"))
+//	results := gokio.JoinAll(futures).Await()
+func JoinAll[T any](futures gost.Vec[gost.Future[T]]) Future[gost.Vec[T]] {
+	return Spawn(func() gost.Vec[T] {
+		channels := []<-chan gost.Result[T]{}
+		for _, future := range futures.AsSlice() {
+			channels = append(channels, _AwaitAsync(future))
+		}
+
+		results := gost.VecWithCapacity[T](gost.USize(len(channels)))
+		for _, ch := range channels {
+			results.Push(_Unwrap(<-ch))
+		}
+
+		return results
+	})
+}
+
+// Waits on every future concurrently, returning their Ok values in the same order once all have completed.
+// Returns the first Err as soon as any future completes with one, without waiting for the others. The others are cancelled if they support it, and their results are discarded.
+func TryJoinAll[T any](futures gost.Vec[gost.Future[gost.Result[T]]]) Future[gost.Result[gost.Vec[T]]] {
+	return Spawn(func() gost.Result[gost.Vec[T]] {
+		type indexed struct {
+			index  int
+			result gost.Result[gost.Result[T]]
+		}
+
+		slice := futures.AsSlice()
+		ch := make(chan indexed, len(slice))
+
+		for i, future := range slice {
+			go func(index int, future gost.Future[gost.Result[T]]) {
+				ch <- indexed{index: index, result: _TryAwait(future)}
+			}(i, future)
+		}
+
+		values := make([]T, len(slice))
+		for range slice {
+			received := <-ch
+			result := _Unwrap(received.result)
+
+			if result.IsErr() {
+				for _, future := range slice {
+					_Cancel(future)
+				}
+
+				return gost.Err[gost.Vec[T]](result.UnwrapErr())
+			}
+
+			values[received.index] = result.Unwrap()
+		}
+
+		results := gost.VecWithCapacity[T](gost.USize(len(values)))
+		for _, value := range values {
+			results.Push(value)
+		}
+
+		return gost.Ok(results)
+	})
+}
+
+// Waits on several futures concurrently, returning the index and the result of whichever completes first.
+// The other futures are cancelled if they support it, like the acquire futures of Mutex and Semaphore or the Recv and Send futures of channels; their results are discarded.
+// Panics if no future is given.
+//
+//	first := gokio.Select(slow, fast).Await()
+//	gost.AssertEq(first.Key, gost.USize(1))
+func Select[T any](futures ...gost.Future[T]) Future[gost.Pair[gost.USize, T]] {
+	if len(futures) == 0 {
+		panic("Select requires at least one future")
+	}
+
+	return Spawn(func() gost.Pair[gost.USize, T] {
+		ch := make(chan gost.Pair[gost.USize, gost.Result[T]], len(futures))
+
+		for i, future := range futures {
+			go func(index gost.USize, future gost.Future[T]) {
+				ch <- gost.Pair[gost.USize, gost.Result[T]]{Key: index, Value: _TryAwait(future)}
+			}(gost.USize(i), future)
+		}
+
+		first := <-ch
+
+		for i, future := range futures {
+			if gost.USize(i) != first.Key {
+				_Cancel(future)
+			}
+		}
+
+		return gost.Pair[gost.USize, T]{Key: first.Key, Value: _Unwrap(first.Value)}
+	})
+}
+
+// The error returned by Timeout when the future did not complete in time.
+type Elapsed struct{}
+
+// impl error for Elapsed
+func (self Elapsed) Error() string {
+	return "deadline has elapsed"
+}
+
+// Requires a future to complete before the specified duration has elapsed.
+// Returns Ok with the result of the future, or an Err of Elapsed if the duration elapsed first.
+// In that case, the future is cancelled if it supports it, like the acquire futures of Mutex and Semaphore or the Recv and Send futures of channels; otherwise it keeps running and its result is discarded.
+//
+//	result := gokio.Timeout(gokio.ReadToString("slow.txt"), gost.DurationFromSecs(1)).Await()
+//	if result.IsErr() {
+//		gost.Println("timed out")
+//	}
+func Timeout[T any](future gost.Future[T], duration gost.Duration) Future[gost.Result[T]] {
+	return Spawn(func() gost.Result[T] {
+		timer := time.NewTimer(duration.ToGoDuration())
+		defer timer.Stop()
+
+		ch := _AwaitAsync(future)
+
+		select {
+		case result := <-ch:
+			return gost.Ok(_Unwrap(result))
+		case <-timer.C:
+			if !_Cancel(future) {
+				// It completed just in time, and what it did cannot be undone.
+				return gost.Ok(_Unwrap(<-ch))
+			}
+
+			return gost.Err[T](Elapsed{})
+		}
+	})
+}
@@ -0,0 +1,102 @@
+package gokio
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/myyrakle/gost"
+)
+
+func _After[T any](milliseconds int, value T) gost.Future[T] {
+	return Spawn(func() T {
+		time.Sleep(time.Duration(milliseconds) * time.Millisecond)
+		return value
+	})
+}
+
+func Test_Join(t *testing.T) {
+	t.Parallel()
+
+	pair := Join2(_After(20, gost.I32(1)), _After(0, gost.String("two"))).Await()
+	gost.AssertEq(pair.Key, gost.I32(1), "Join2")
+	gost.AssertEq(pair.Value, gost.String("two"), "Join2")
+
+	triple := Join3(_After(0, gost.I32(1)), _After(0, gost.I32(2)), _After(0, gost.I32(3))).Await()
+	gost.AssertEq(triple.First+triple.Second+triple.Third, gost.I32(6), "Join3")
+
+	start := time.Now()
+	futures := gost.VecNew[gost.Future[gost.I32]]()
+	for i := gost.I32(0); i < 5; i++ {
+		futures.Push(_After(20, i))
+	}
+
+	results := JoinAll(futures).Await()
+	expected := gost.VecNew[gost.I32]()
+	for i := gost.I32(0); i < 5; i++ {
+		expected.Push(i)
+	}
+	gost.AssertEq(results, expected, "JoinAll keeps the order")
+	gost.Assert(gost.Bool(time.Since(start) < 90*time.Millisecond), "JoinAll waits concurrently")
+}
+
+func Test_TryJoinAll(t *testing.T) {
+	t.Parallel()
+
+	futures := gost.VecNew[gost.Future[gost.Result[gost.I32]]]()
+	futures.Push(_After(10, gost.Ok[gost.I32](1)))
+	futures.Push(_After(0, gost.Ok[gost.I32](2)))
+	gost.AssertEq(TryJoinAll(futures).Await().Unwrap().Len(), gost.USize(2), "TryJoinAll")
+
+	failure := errors.New("failure")
+	start := time.Now()
+	futures = gost.VecNew[gost.Future[gost.Result[gost.I32]]]()
+	futures.Push(_After(500, gost.Ok[gost.I32](1)))
+	futures.Push(_After(0, gost.Err[gost.I32](failure)))
+
+	result := TryJoinAll(futures).Await()
+	gost.Assert(result.IsErr() && gost.Bool(result.UnwrapErr() == failure), "TryJoinAll returns the error")
+	gost.Assert(gost.Bool(time.Since(start) < 250*time.Millisecond), "TryJoinAll short-circuits")
+}
+
+func Test_Select_Timeout(t *testing.T) {
+	t.Parallel()
+
+	first := Select(_After(200, gost.I32(1)), _After(0, gost.I32(2))).Await()
+	gost.AssertEq(first.Key, gost.USize(1), "Select returns the index of the first")
+	gost.AssertEq(first.Value, gost.I32(2), "Select returns the value of the first")
+
+	gost.AssertEq(Timeout(_After(0, gost.I32(1)), gost.DurationFromSecs(1)).Await(), gost.Ok[gost.I32](1), "Timeout in time")
+
+	late := Timeout(_After(200, gost.I32(1)), gost.DurationFromMillis(10)).Await()
+	gost.Assert(late.IsErr() && gost.Bool(errors.Is(late.UnwrapErr(), Elapsed{})), "Timeout elapsed")
+}
+
+func Test_Join_Panic(t *testing.T) {
+	t.Parallel()
+
+	boom := func() gost.Future[gost.I32] { return Spawn(func() gost.I32 { panic("boom") }) }
+
+	isBoom := func(result gost.Result[any]) gost.Bool {
+		if result.IsOk() {
+			return false
+		}
+
+		panicError, ok := result.UnwrapErr().(gost.PanicError)
+		return gost.Bool(ok && panicError.Payload() == "boom")
+	}
+
+	gost.Assert(isBoom(Spawn(func() any { return Join2(boom(), _After(0, gost.I32(1))).Await() }).TryAwait()), "Join2 reports the panic")
+
+	futures := gost.VecNew[gost.Future[gost.I32]]()
+	futures.Push(_After(0, gost.I32(1)))
+	futures.Push(boom())
+	gost.Assert(isBoom(Spawn(func() any { return JoinAll(futures).Await() }).TryAwait()), "JoinAll reports the panic")
+
+	gost.Assert(isBoom(Spawn(func() any { return Select(boom(), _After(200, gost.I32(1))).Await() }).TryAwait()), "Select reports the panic")
+	gost.Assert(isBoom(Spawn(func() any { return Timeout(boom(), gost.DurationFromSecs(1)).Await() }).TryAwait()), "Timeout reports the panic")
+
+	failing := gost.VecNew[gost.Future[gost.Result[gost.I32]]]()
+	failing.Push(Spawn(func() gost.Result[gost.I32] { panic("boom") }))
+	gost.Assert(isBoom(Spawn(func() any { return TryJoinAll(failing).Await() }).TryAwait()), "TryJoinAll reports the panic")
+}
@@ -0,0 +1,296 @@
+package gokio
+
+import (
+	"sync"
+
+	"github.com/myyrakle/gost"
+)
+
+type _MpscState[T any] struct {
+	lock           sync.Mutex
+	changed        _Changed
+	queue          []_MpscEntry[T]
+	nextID         gost.U64
+	capacity       gost.USize
+	bounded        bool
+	senders        gost.USize
+	receiverClosed bool
+}
+
+// A value in an mpsc channel. The id tells it apart from the others, so a cancelled Send can take it back.
+type _MpscEntry[T any] struct {
+	id    gost.U64
+	value T
+}
+
+func _MpscStateNew[T any](capacity gost.USize, bounded bool) *_MpscState[T] {
+	return &_MpscState[T]{capacity: capacity, bounded: bounded, senders: 1}
+}
+
+func (self *_MpscState[T]) clone() {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	self.senders++
+}
+
+func (self *_MpscState[T]) closeSender() {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	self.senders--
+	if self.senders == 0 {
+		self.changed.notify()
+	}
+}
+
+func (self *_MpscState[T]) isClosed() gost.Bool {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	return gost.Bool(self.receiverClosed)
+}
+
+// Pushes value if there is room. Must be called with the lock held.
+func (self *_MpscState[T]) tryPush(value T) gost.Result[any] {
+	if self.receiverClosed {
+		return gost.Err[any](TrySendError[T]{value: value, closed: true})
+	}
+
+	if self.bounded && gost.USize(len(self.queue)) >= self.capacity {
+		return gost.Err[any](TrySendError[T]{value: value, closed: false})
+	}
+
+	self.queue = append(self.queue, _MpscEntry[T]{id: self.nextID, value: value})
+	self.nextID++
+	self.changed.notify()
+
+	return gost.Ok[any](nil)
+}
+
+// Removes the oldest value. Must be called with the lock held, on a non-empty queue.
+func (self *_MpscState[T]) pop() _MpscEntry[T] {
+	entry := self.queue[0]
+
+	self.queue[0] = _MpscEntry[T]{}
+	self.queue = self.queue[1:]
+	self.changed.notify()
+
+	return entry
+}
+
+// Removes the value with the given id, returning false if it has already been received. Must be called with the lock held.
+func (self *_MpscState[T]) remove(id gost.U64) bool {
+	for i, entry := range self.queue {
+		if entry.id == id {
+			self.queue = append(self.queue[:i], self.queue[i+1:]...)
+			self.changed.notify()
+			return true
+		}
+	}
+
+	return false
+}
+
+// Puts a received value back at the front. Must be called with the lock held.
+func (self *_MpscState[T]) unpop(entry _MpscEntry[T]) {
+	self.queue = append([]_MpscEntry[T]{entry}, self.queue...)
+	self.changed.notify()
+}
+
+// Creates a bounded channel for communicating between tasks, with many senders and one receiver.
+// The channel holds at most buffer values; once it is full, Send waits until the receiver makes room. Panics if buffer is 0.
+// Clone the sender to send from several tasks, and close every sender so the receiver sees the end of the channel.
+//
+//	sender, receiver := gokio.MpscChannel[gost.I32](16)
+//	gokio.Spawn(func() any {
+//		defer sender.Close()
+//		for i := gost.I32(0); i < 3; i++ {
+//			sender.Send(i).Await()
+//		}
+//		return nil
+//	})
+//
+//	for value := receiver.Recv().Await(); value.IsSome(); value = receiver.Recv().Await() {
+//		gost.Println("{}", value.Unwrap())
+//	}
+func MpscChannel[T any](buffer gost.USize) (MpscSender[T], MpscReceiver[T]) {
+	if buffer == 0 {
+		panic("mpsc bounded channel requires buffer > 0")
+	}
+
+	state := _MpscStateNew[T](buffer, true)
+
+	return MpscSender[T]{state: state, once: &sync.Once{}}, MpscReceiver[T]{state: state}
+}
+
+// Creates an unbounded channel for communicating between tasks, with many senders and one receiver.
+// Send never waits, so the channel grows without limit if the receiver falls behind.
+func MpscUnboundedChannel[T any]() (MpscUnboundedSender[T], MpscReceiver[T]) {
+	state := _MpscStateNew[T](0, false)
+
+	return MpscUnboundedSender[T]{state: state, once: &sync.Once{}}, MpscReceiver[T]{state: state}
+}
+
+// The sending half of a bounded mpsc channel, created by MpscChannel.
+type MpscSender[T any] struct {
+	state *_MpscState[T]
+	once  *sync.Once
+}
+
+// Sends a value, waiting until there is room in the channel.
+// Returns an Err of SendError, carrying the value back, if the receiver has been closed.
+// When Timeout or Select discards the result of Send, the value is not sent, or is taken back if it has not been received yet.
+func (self MpscSender[T]) Send(value T) Future[gost.Result[any]] {
+	var id gost.U64
+
+	attempt := func() gost.Option[gost.Result[any]] {
+		result := self.state.tryPush(value)
+
+		if result.IsOk() {
+			id = self.state.queue[len(self.state.queue)-1].id
+			return gost.Some(result)
+		}
+
+		if result.UnwrapErr().(TrySendError[T]).IsClosed() {
+			return gost.Some(gost.Err[any](SendError[T]{value: value}))
+		}
+
+		return gost.None[gost.Result[any]]()
+	}
+
+	undo := func(result gost.Result[any]) bool {
+		return bool(result.IsErr()) || self.state.remove(id)
+	}
+
+	return _ChannelOp(&self.state.lock, &self.state.changed, attempt, undo)
+}
+
+// Sends a value if there is room in the channel right now.
+// Returns an Err of TrySendError, carrying the value back, if the channel is full or the receiver has been closed.
+func (self MpscSender[T]) TrySend(value T) gost.Result[any] {
+	self.state.lock.Lock()
+	defer self.state.lock.Unlock()
+
+	return self.state.tryPush(value)
+}
+
+// Checks if the receiver has been closed.
+func (self MpscSender[T]) IsClosed() gost.Bool {
+	return self.state.isClosed()
+}
+
+// Closes this sender. Once every sender is closed, the receiver gets None after the values left in the channel.
+// Closing a sender twice does nothing.
+func (self MpscSender[T]) Close() {
+	self.once.Do(self.state.closeSender)
+}
+
+// impl Clone for MpscSender
+// Returns another sender for the same channel, which must be closed on its own.
+func (self MpscSender[T]) Clone() MpscSender[T] {
+	self.state.clone()
+	return MpscSender[T]{state: self.state, once: &sync.Once{}}
+}
+
+// The sending half of an unbounded mpsc channel, created by MpscUnboundedChannel.
+type MpscUnboundedSender[T any] struct {
+	state *_MpscState[T]
+	once  *sync.Once
+}
+
+// Sends a value without waiting.
+// Returns an Err of SendError, carrying the value back, if the receiver has been closed.
+func (self MpscUnboundedSender[T]) Send(value T) gost.Result[any] {
+	self.state.lock.Lock()
+	defer self.state.lock.Unlock()
+
+	if self.state.tryPush(value).IsErr() {
+		return gost.Err[any](SendError[T]{value: value})
+	}
+
+	return gost.Ok[any](nil)
+}
+
+// Checks if the receiver has been closed.
+func (self MpscUnboundedSender[T]) IsClosed() gost.Bool {
+	return self.state.isClosed()
+}
+
+// Closes this sender. Once every sender is closed, the receiver gets None after the values left in the channel.
+// Closing a sender twice does nothing.
+func (self MpscUnboundedSender[T]) Close() {
+	self.once.Do(self.state.closeSender)
+}
+
+// impl Clone for MpscUnboundedSender
+// Returns another sender for the same channel, which must be closed on its own.
+func (self MpscUnboundedSender[T]) Clone() MpscUnboundedSender[T] {
+	self.state.clone()
+	return MpscUnboundedSender[T]{state: self.state, once: &sync.Once{}}
+}
+
+// The receiving half of an mpsc channel, created by MpscChannel or MpscUnboundedChannel.
+type MpscReceiver[T any] struct {
+	state *_MpscState[T]
+}
+
+// Receives the next value, waiting until one is sent.
+// Returns None once every sender, or the receiver itself, has been closed and no value is left.
+// The value is taken off the channel as soon as it arrives, even if the future is never awaited. When Timeout or Select discards the result of Recv, the value is put back instead.
+func (self MpscReceiver[T]) Recv() Future[gost.Option[T]] {
+	var entry _MpscEntry[T]
+
+	attempt := func() gost.Option[gost.Option[T]] {
+		if len(self.state.queue) > 0 {
+			entry = self.state.pop()
+			return gost.Some(gost.Some(entry.value))
+		}
+
+		if self.state.senders == 0 || self.state.receiverClosed {
+			return gost.Some(gost.None[T]())
+		}
+
+		return gost.None[gost.Option[T]]()
+	}
+
+	undo := func(value gost.Option[T]) bool {
+		if value.IsSome() {
+			self.state.unpop(entry)
+		}
+
+		return true
+	}
+
+	return _ChannelOp(&self.state.lock, &self.state.changed, attempt, undo)
+}
+
+// Receives the next value if one is in the channel right now.
+// Returns an Err of TryRecvError if the channel is empty, or if every sender has been closed and no value is left.
+func (self MpscReceiver[T]) TryRecv() gost.Result[T] {
+	self.state.lock.Lock()
+	defer self.state.lock.Unlock()
+
+	if len(self.state.queue) > 0 {
+		return gost.Ok(self.state.pop().value)
+	}
+
+	return gost.Err[T](TryRecvError{disconnected: self.state.senders == 0 || self.state.receiverClosed})
+}
+
+// Closes the receiver. Sending fails from now on, but the values already in the channel can still be received.
+func (self MpscReceiver[T]) Close() {
+	self.state.lock.Lock()
+	defer self.state.lock.Unlock()
+
+	self.state.receiverClosed = true
+	self.state.changed.notify()
+}
+
+// Returns the number of values in the channel.
+func (self MpscReceiver[T]) Len() gost.USize {
+	self.state.lock.Lock()
+	defer self.state.lock.Unlock()
+
+	return gost.USize(len(self.state.queue))
+}
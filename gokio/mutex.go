@@ -0,0 +1,176 @@
+package gokio
+
+import "github.com/myyrakle/gost"
+
+// The number of readers that can hold an RwLock at once. A writer acquires all of them.
+const _MAX_READS = 1 << 30
+
+// A mutual exclusion lock for tasks, whose Lock returns a future instead of blocking.
+// Waiting tasks get the lock in the order they asked for it. Copies of a Mutex share the same lock and value.
+//
+//	counter := gokio.MutexNew(gost.I32(0))
+//	guard := counter.Lock().Await()
+//	guard.Set(guard.Get() + 1)
+//	guard.Drop()
+type Mutex[T any] struct {
+	value     *T
+	semaphore *_SemaphoreState
+}
+
+// Creates a new mutex holding value.
+func MutexNew[T any](value T) Mutex[T] {
+	return Mutex[T]{value: &value, semaphore: SemaphoreNew(1).state}
+}
+
+// Acquires the mutex, waiting until it is unlocked.
+// When Timeout or Select discards the result of Lock, the lock is given up, so it never stays locked.
+//
+//	guard := gokio.Timeout[gokio.MutexGuard[gost.I32]](mutex.Lock(), gost.DurationFromSecs(1)).Await()
+//	if guard.IsErr() {
+//		gost.Println("timed out")
+//	}
+func (self Mutex[T]) Lock() Future[MutexGuard[T]] {
+	return _Acquire(self.semaphore, 1, func(permit SemaphorePermit) MutexGuard[T] {
+		return MutexGuard[T]{value: self.value, permit: permit}
+	})
+}
+
+// Acquires the mutex if it is unlocked right now, or returns an Err of gost.TryLockError.
+func (self Mutex[T]) TryLock() gost.Result[MutexGuard[T]] {
+	permit := self.semaphore.tryAcquire(1)
+
+	if permit.IsErr() {
+		return gost.Err[MutexGuard[T]](gost.TryLockError{})
+	} else {
+		return gost.Ok(MutexGuard[T]{value: self.value, permit: permit.Unwrap()})
+	}
+}
+
+// Access to the value of a locked Mutex, which is unlocked by Drop. A guard cannot be used after it is dropped.
+type MutexGuard[T any] struct {
+	value  *T
+	permit SemaphorePermit
+}
+
+// Returns a copy of the value.
+func (self MutexGuard[T]) Get() T {
+	self.permit.checkHeld("MutexGuard")
+	return *self.value
+}
+
+// Replaces the value.
+func (self MutexGuard[T]) Set(value T) {
+	self.permit.checkHeld("MutexGuard")
+	*self.value = value
+}
+
+// Returns a pointer to the value, to modify it in place. The pointer must not be used after the guard is dropped.
+func (self MutexGuard[T]) AsMut() *T {
+	self.permit.checkHeld("MutexGuard")
+	return self.value
+}
+
+// Unlocks the mutex. Dropping twice does nothing.
+func (self MutexGuard[T]) Drop() {
+	self.permit.Release()
+}
+
+// A reader-writer lock for tasks, whose Read and Write return futures instead of blocking.
+// Any number of readers can hold the lock at once, or a single writer. Tasks get the lock in the order they asked for it, so writers are not starved by readers.
+// Copies of an RwLock share the same lock and value.
+//
+//	config := gokio.RwLockNew(gost.String("v1"))
+//	reader := config.Read().Await()
+//	gost.AssertEq(reader.Get(), gost.String("v1"))
+//	reader.Drop()
+type RwLock[T any] struct {
+	value     *T
+	semaphore *_SemaphoreState
+}
+
+// Creates a new reader-writer lock holding value.
+func RwLockNew[T any](value T) RwLock[T] {
+	return RwLock[T]{value: &value, semaphore: SemaphoreNew(_MAX_READS).state}
+}
+
+// Acquires shared read access, waiting until no writer holds or is waiting for the lock.
+func (self RwLock[T]) Read() Future[RwLockReadGuard[T]] {
+	return _Acquire(self.semaphore, 1, func(permit SemaphorePermit) RwLockReadGuard[T] {
+		return RwLockReadGuard[T]{value: self.value, permit: permit}
+	})
+}
+
+// Acquires exclusive write access, waiting until no one else holds the lock.
+func (self RwLock[T]) Write() Future[RwLockWriteGuard[T]] {
+	return _Acquire(self.semaphore, _MAX_READS, func(permit SemaphorePermit) RwLockWriteGuard[T] {
+		return RwLockWriteGuard[T]{value: self.value, permit: permit}
+	})
+}
+
+// Acquires shared read access if it is available right now, or returns an Err of gost.TryLockError.
+func (self RwLock[T]) TryRead() gost.Result[RwLockReadGuard[T]] {
+	permit := self.semaphore.tryAcquire(1)
+
+	if permit.IsErr() {
+		return gost.Err[RwLockReadGuard[T]](gost.TryLockError{})
+	} else {
+		return gost.Ok(RwLockReadGuard[T]{value: self.value, permit: permit.Unwrap()})
+	}
+}
+
+// Acquires exclusive write access if it is available right now, or returns an Err of gost.TryLockError.
+func (self RwLock[T]) TryWrite() gost.Result[RwLockWriteGuard[T]] {
+	permit := self.semaphore.tryAcquire(_MAX_READS)
+
+	if permit.IsErr() {
+		return gost.Err[RwLockWriteGuard[T]](gost.TryLockError{})
+	} else {
+		return gost.Ok(RwLockWriteGuard[T]{value: self.value, permit: permit.Unwrap()})
+	}
+}
+
+// Shared read access to the value of an RwLock, which is released by Drop. A guard cannot be used after it is dropped.
+type RwLockReadGuard[T any] struct {
+	value  *T
+	permit SemaphorePermit
+}
+
+// Returns a copy of the value.
+func (self RwLockReadGuard[T]) Get() T {
+	self.permit.checkHeld("RwLockReadGuard")
+	return *self.value
+}
+
+// Releases read access. Dropping twice does nothing.
+func (self RwLockReadGuard[T]) Drop() {
+	self.permit.Release()
+}
+
+// Exclusive write access to the value of an RwLock, which is released by Drop. A guard cannot be used after it is dropped.
+type RwLockWriteGuard[T any] struct {
+	value  *T
+	permit SemaphorePermit
+}
+
+// Returns a copy of the value.
+func (self RwLockWriteGuard[T]) Get() T {
+	self.permit.checkHeld("RwLockWriteGuard")
+	return *self.value
+}
+
+// Replaces the value.
+func (self RwLockWriteGuard[T]) Set(value T) {
+	self.permit.checkHeld("RwLockWriteGuard")
+	*self.value = value
+}
+
+// Returns a pointer to the value, to modify it in place. The pointer must not be used after the guard is dropped.
+func (self RwLockWriteGuard[T]) AsMut() *T {
+	self.permit.checkHeld("RwLockWriteGuard")
+	return self.value
+}
+
+// Releases write access. Dropping twice does nothing.
+func (self RwLockWriteGuard[T]) Drop() {
+	self.permit.Release()
+}
@@ -0,0 +1,195 @@
+package gokio
+
+import (
+	"sync"
+
+	"github.com/myyrakle/gost"
+)
+
+// Notifies a single task, or every waiting task, of an event. It carries no data.
+// A NotifyOne with no task waiting is stored, and completes the next Notified right away. Copies of a Notify share the same waiters.
+//
+//	notify := gokio.NotifyNew()
+//	notified := notify.Notified()
+//	notify.NotifyOne()
+//	notified.Await()
+type Notify struct {
+	state *_NotifyState
+}
+
+type _NotifyState struct {
+	lock    sync.Mutex
+	permit  bool
+	waiters []*_NotifyWaiter
+}
+
+type _NotifyWaiter struct {
+	ready     chan struct{}
+	cancelled chan struct{}
+	notified  bool
+	byOne     bool
+}
+
+// Creates a new Notify, with no stored notification.
+func NotifyNew() Notify {
+	return Notify{state: &_NotifyState{}}
+}
+
+// Wakes the first waiter. Must be called with the lock held.
+func (self *_NotifyState) notifyOne() {
+	if len(self.waiters) == 0 {
+		self.permit = true
+		return
+	}
+
+	waiter := self.waiters[0]
+	self.waiters = self.waiters[1:]
+	waiter.notified = true
+	waiter.byOne = true
+	close(waiter.ready)
+}
+
+// Waits for a notification. The task starts waiting when Notified is called, not when the future is awaited, so a notification sent in between is not missed.
+func (self Notify) Notified() Future[any] {
+	state := self.state
+	waiter := &_NotifyWaiter{ready: make(chan struct{}), cancelled: make(chan struct{})}
+
+	state.lock.Lock()
+	if state.permit {
+		state.permit = false
+		waiter.notified = true
+		waiter.byOne = true
+		close(waiter.ready)
+	} else {
+		state.waiters = append(state.waiters, waiter)
+	}
+	state.lock.Unlock()
+
+	onCancel := func() bool {
+		state.lock.Lock()
+		defer state.lock.Unlock()
+
+		if !_CloseOnce(waiter.cancelled) {
+			return true
+		}
+
+		if !waiter.notified {
+			for i, other := range state.waiters {
+				if other == waiter {
+					state.waiters = append(state.waiters[:i], state.waiters[i+1:]...)
+					break
+				}
+			}
+		} else if waiter.byOne {
+			// The notification was meant for one task; pass it on instead of losing it.
+			state.notifyOne()
+		}
+
+		return true
+	}
+
+	return &_WaitFuture[any]{ready: waiter.ready, cancelled: waiter.cancelled, value: nil, onCancel: onCancel}
+}
+
+// Wakes the task that has waited the longest. If no task is waiting, the notification is stored for the next Notified.
+func (self Notify) NotifyOne() {
+	self.state.lock.Lock()
+	defer self.state.lock.Unlock()
+
+	self.state.notifyOne()
+}
+
+// Wakes every task that is waiting. Unlike NotifyOne, nothing is stored if no task is waiting.
+func (self Notify) NotifyWaiters() {
+	self.state.lock.Lock()
+	defer self.state.lock.Unlock()
+
+	for _, waiter := range self.state.waiters {
+		waiter.notified = true
+		close(waiter.ready)
+	}
+
+	self.state.waiters = nil
+}
+
+// Makes a group of tasks wait until all of them have reached the barrier, then releases them together. It can be used again afterwards.
+//
+//	barrier := gokio.BarrierNew(3)
+//	for i := 0; i < 3; i++ {
+//		gokio.Spawn(func() any {
+//			// ...
+//			return barrier.Wait().Await()
+//		})
+//	}
+type Barrier struct {
+	state *_BarrierState
+}
+
+type _BarrierState struct {
+	lock       sync.Mutex
+	n          gost.USize
+	count      gost.USize
+	generation chan struct{}
+}
+
+// Creates a barrier for n tasks. A barrier for 0 tasks behaves like one for 1 task.
+func BarrierNew(n gost.USize) Barrier {
+	if n == 0 {
+		n = 1
+	}
+
+	return Barrier{state: &_BarrierState{n: n, generation: make(chan struct{})}}
+}
+
+// The result of Barrier.Wait.
+type BarrierWaitResult struct {
+	leader bool
+}
+
+// Returns true for exactly one task of each group released by the barrier: the last one to arrive.
+func (self BarrierWaitResult) IsLeader() gost.Bool {
+	return gost.Bool(self.leader)
+}
+
+// Waits until n tasks have called Wait.
+func (self Barrier) Wait() Future[BarrierWaitResult] {
+	state := self.state
+	cancelled := make(chan struct{})
+
+	state.lock.Lock()
+	defer state.lock.Unlock()
+
+	generation := state.generation
+	state.count++
+
+	if state.count == state.n {
+		close(generation)
+		state.count = 0
+		state.generation = make(chan struct{})
+
+		return &_WaitFuture[BarrierWaitResult]{ready: generation, cancelled: cancelled, value: BarrierWaitResult{leader: true}, onCancel: func() bool { return false }}
+	}
+
+	onCancel := func() bool {
+		state.lock.Lock()
+		defer state.lock.Unlock()
+
+		select {
+		case <-cancelled:
+			return true
+		default:
+		}
+
+		// Once the group is complete, the task was counted and released, so the result stands.
+		if state.generation != generation {
+			return false
+		}
+
+		// A task that gives up before the group is complete no longer counts towards it.
+		close(cancelled)
+		state.count--
+		return true
+	}
+
+	return &_WaitFuture[BarrierWaitResult]{ready: generation, cancelled: cancelled, value: BarrierWaitResult{leader: false}, onCancel: onCancel}
+}
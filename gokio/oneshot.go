@@ -0,0 +1,106 @@
+package gokio
+
+import (
+	"sync"
+
+	"github.com/myyrakle/gost"
+)
+
+type _OneshotState[T any] struct {
+	lock           sync.Mutex
+	done           chan struct{}
+	value          gost.Option[T]
+	senderClosed   bool
+	receiverClosed bool
+}
+
+// Creates a channel for sending a single value between tasks.
+// The sender either sends one value or is closed without sending; in both cases, the receiver stops waiting.
+//
+//	sender, receiver := gokio.OneshotChannel[gost.String]()
+//	gokio.Spawn(func() any { return sender.Send("done") })
+//	gost.AssertEq(receiver.Recv().Await(), gost.Some[gost.String]("done"))
+func OneshotChannel[T any]() (OneshotSender[T], OneshotReceiver[T]) {
+	state := &_OneshotState[T]{done: make(chan struct{}), value: gost.None[T]()}
+
+	return OneshotSender[T]{state: state}, OneshotReceiver[T]{state: state}
+}
+
+// The sending half of a oneshot channel, created by OneshotChannel.
+type OneshotSender[T any] struct {
+	state *_OneshotState[T]
+}
+
+// Sends the value, completing the channel.
+// Returns an Err of SendError, carrying the value back, if the receiver has been closed or a value was already sent.
+func (self OneshotSender[T]) Send(value T) gost.Result[any] {
+	self.state.lock.Lock()
+	defer self.state.lock.Unlock()
+
+	if self.state.receiverClosed || self.state.senderClosed {
+		return gost.Err[any](SendError[T]{value: value})
+	}
+
+	self.state.value = gost.Some(value)
+	self.state.senderClosed = true
+	close(self.state.done)
+
+	return gost.Ok[any](nil)
+}
+
+// Checks if the receiver has been closed.
+func (self OneshotSender[T]) IsClosed() gost.Bool {
+	self.state.lock.Lock()
+	defer self.state.lock.Unlock()
+
+	return gost.Bool(self.state.receiverClosed)
+}
+
+// Closes the sender without sending a value, so the receiver gets None. Does nothing if a value was already sent.
+func (self OneshotSender[T]) Close() {
+	self.state.lock.Lock()
+	defer self.state.lock.Unlock()
+
+	if !self.state.senderClosed {
+		self.state.senderClosed = true
+		close(self.state.done)
+	}
+}
+
+// The receiving half of a oneshot channel, created by OneshotChannel.
+type OneshotReceiver[T any] struct {
+	state *_OneshotState[T]
+}
+
+// Waits for the value. Returns None if the sender was closed without sending one.
+func (self OneshotReceiver[T]) Recv() Future[gost.Option[T]] {
+	return Spawn(func() gost.Option[T] {
+		<-self.state.done
+
+		self.state.lock.Lock()
+		defer self.state.lock.Unlock()
+
+		return self.state.value
+	})
+}
+
+// Returns the value if it has been sent.
+// Returns an Err of TryRecvError if it has not been sent yet, or if the sender was closed without sending one.
+func (self OneshotReceiver[T]) TryRecv() gost.Result[T] {
+	self.state.lock.Lock()
+	defer self.state.lock.Unlock()
+
+	if self.state.value.IsSome() {
+		return gost.Ok(self.state.value.Unwrap())
+	}
+
+	return gost.Err[T](TryRecvError{disconnected: self.state.senderClosed})
+}
+
+// Closes the receiver, so the sender fails to send. A value that was already sent can still be received.
+func (self OneshotReceiver[T]) Close() {
+	self.state.lock.Lock()
+	defer self.state.lock.Unlock()
+
+	self.state.receiverClosed = true
+}
@@ -0,0 +1,356 @@
+package gokio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	goruntime "runtime"
+	"runtime/pprof"
+	"sync"
+	"time"
+
+	"github.com/myyrakle/gost"
+)
+
+// Builds a Runtime with custom configuration values.
+//
+//	runtime := gokio.RuntimeBuilderNew().WorkerThreads(4).QueueSize(64).ThreadNamePrefix("worker").Build().Unwrap()
+//	defer runtime.Shutdown(gost.DurationFromSecs(5))
+type RuntimeBuilder struct {
+	workerThreads    gost.USize
+	queueSize        gost.USize
+	threadNamePrefix gost.String
+}
+
+// Returns a new builder. By default, there is one worker per CPU, the queue holds 1024 tasks, and workers are named "gokio-runtime-worker-N".
+func RuntimeBuilderNew() RuntimeBuilder {
+	return RuntimeBuilder{
+		workerThreads:    gost.USize(goruntime.NumCPU()),
+		queueSize:        1024,
+		threadNamePrefix: "gokio-runtime-worker",
+	}
+}
+
+// Sets the number of worker goroutines the Runtime will use. It must be greater than 0.
+func (self RuntimeBuilder) WorkerThreads(count gost.USize) RuntimeBuilder {
+	self.workerThreads = count
+	return self
+}
+
+// Sets how many spawned tasks can wait for a free worker. Spawning onto a full queue blocks until a worker takes a task.
+func (self RuntimeBuilder) QueueSize(size gost.USize) RuntimeBuilder {
+	self.queueSize = size
+	return self
+}
+
+// Sets the name prefix of the workers. Worker N is labelled "prefix-N" in goroutine profiles, under the "thread" pprof label.
+func (self RuntimeBuilder) ThreadNamePrefix(prefix gost.String) RuntimeBuilder {
+	self.threadNamePrefix = prefix
+	return self
+}
+
+// Creates the configured Runtime and starts its workers.
+func (self RuntimeBuilder) Build() gost.Result[*Runtime] {
+	if self.workerThreads == 0 {
+		return gost.Err[*Runtime](errors.New("worker threads cannot be set to 0"))
+	}
+
+	runtime := &Runtime{
+		queue:     make(chan _Task, self.queueSize),
+		stop:      make(chan struct{}),
+		cancelled: make(chan struct{}),
+		tasks:     map[_Task]struct{}{},
+	}
+
+	for i := gost.USize(0); i < self.workerThreads; i++ {
+		name := fmt.Sprintf("%s-%d", self.threadNamePrefix, i)
+		runtime.workers.Add(1)
+
+		go pprof.Do(context.Background(), pprof.Labels("thread", name), func(context.Context) {
+			defer runtime.workers.Done()
+			runtime.work()
+		})
+	}
+
+	return gost.Ok(runtime)
+}
+
+// A pool of worker goroutines that runs spawned tasks, with a bounded queue of tasks waiting for a worker.
+// Create one with RuntimeNew or RuntimeBuilderNew, spawn tasks with SpawnOn and BlockOn, and stop it with Shutdown.
+type Runtime struct {
+	queue     chan _Task
+	stop      chan struct{}
+	cancelled chan struct{}
+	workers   sync.WaitGroup
+	pending   sync.WaitGroup
+
+	lock   sync.Mutex
+	closed bool
+	tasks  map[_Task]struct{}
+}
+
+// Creates a Runtime with the default configuration of RuntimeBuilderNew.
+func RuntimeNew() gost.Result[*Runtime] {
+	return RuntimeBuilderNew().Build()
+}
+
+// A spawned task, from the point of view of the Runtime.
+type _Task interface {
+	run()
+	cancel()
+}
+
+func (self *Runtime) work() {
+	for {
+		select {
+		case task := <-self.queue:
+			select {
+			case <-self.cancelled:
+				task.cancel()
+			default:
+				task.run()
+			}
+			self.finish(task)
+		case <-self.stop:
+			// Tasks still queued after a timed out shutdown are cancelled without running.
+			for {
+				select {
+				case task := <-self.queue:
+					task.cancel()
+					self.finish(task)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+func (self *Runtime) finish(task _Task) {
+	self.lock.Lock()
+	delete(self.tasks, task)
+	self.lock.Unlock()
+
+	self.pending.Done()
+}
+
+func (self *Runtime) submit(task _Task) {
+	self.lock.Lock()
+
+	if self.closed {
+		self.lock.Unlock()
+		task.cancel()
+		return
+	}
+
+	self.tasks[task] = struct{}{}
+	self.pending.Add(1)
+	self.lock.Unlock()
+
+	select {
+	case self.queue <- task:
+	case <-self.cancelled:
+		task.cancel()
+		self.finish(task)
+	}
+}
+
+// Shuts down the runtime. No new task can be spawned, and spawning one returns a cancelled JoinHandle.
+// Queued and running tasks are given until timeout to finish. After that, every JoinHandle still outstanding is cancelled and queued tasks are dropped.
+// A task that is already running cannot be stopped; it keeps its worker until it returns, but its result is discarded.
+// Returns true if every task finished before the timeout.
+func (self *Runtime) Shutdown(timeout gost.Duration) gost.Bool {
+	self.lock.Lock()
+	if self.closed {
+		self.lock.Unlock()
+		return true
+	}
+	self.closed = true
+	self.lock.Unlock()
+
+	drained := make(chan struct{})
+	go func() {
+		self.pending.Wait()
+		close(drained)
+	}()
+
+	timer := time.NewTimer(timeout.ToGoDuration())
+	defer timer.Stop()
+
+	select {
+	case <-drained:
+		close(self.stop)
+		return true
+	case <-timer.C:
+		close(self.cancelled)
+
+		self.lock.Lock()
+		for task := range self.tasks {
+			task.cancel()
+		}
+		self.lock.Unlock()
+
+		close(self.stop)
+		return false
+	}
+}
+
+// The error of a task that did not return a value, because it was cancelled or it panicked.
+type JoinError struct {
+	cancelled bool
+	panic     gost.Option[gost.PanicError]
+}
+
+// Returns true if the task was cancelled by JoinHandle.Abort or Runtime.Shutdown.
+func (self JoinError) IsCancelled() gost.Bool {
+	return gost.Bool(self.cancelled)
+}
+
+// Returns true if the task panicked.
+func (self JoinError) IsPanic() gost.Bool {
+	return self.panic.IsSome()
+}
+
+// Returns the panic of the task, with its payload and stack, if it panicked.
+func (self JoinError) Panic() gost.Option[gost.PanicError] {
+	return self.panic
+}
+
+// impl error for JoinError
+func (self JoinError) Error() string {
+	if self.panic.IsSome() {
+		return "task " + self.panic.Unwrap().Error()
+	}
+
+	return "task was cancelled"
+}
+
+// Unwrap returns the gost.PanicError of a task that panicked, for errors.Is and errors.As.
+func (self JoinError) Unwrap() error {
+	if self.panic.IsSome() {
+		return self.panic.Unwrap()
+	}
+
+	return nil
+}
+
+// An owned permission to await a task spawned by SpawnOn. A JoinHandle is a Future of the task's result.
+type JoinHandle[T any] struct {
+	state *_JoinState[T]
+}
+
+type _JoinState[T any] struct {
+	f         func() T
+	done      chan struct{}
+	once      sync.Once
+	result    gost.Result[T]
+	cancelled bool
+}
+
+// Spawns a task onto runtime, returning a JoinHandle for its result.
+// If the queue of runtime is full, this blocks until a worker takes a task. If runtime is shut down, the returned handle is already cancelled.
+//
+//	handle := gokio.SpawnOn(runtime, func() gost.I32 { return 42 })
+//	gost.AssertEq(handle.Await(), gost.I32(42))
+func SpawnOn[T any](runtime *Runtime, f func() T) JoinHandle[T] {
+	state := &_JoinState[T]{f: f, done: make(chan struct{})}
+	runtime.submit(state)
+
+	return JoinHandle[T]{state: state}
+}
+
+// Runs f on runtime and blocks the calling goroutine until it returns, returning its result.
+// It is the entry point from synchronous code into the runtime; calling it from a task of the same runtime can deadlock if every worker is busy.
+// Panics with a JoinError if the task panics or is cancelled by Shutdown.
+//
+//	value := gokio.BlockOn(runtime, func() gost.I32 {
+//		return gokio.SpawnOn(runtime, func() gost.I32 { return 1 }).Await() + 1
+//	})
+func BlockOn[T any](runtime *Runtime, f func() T) T {
+	return SpawnOn(runtime, f).Await()
+}
+
+func (self *_JoinState[T]) run() {
+	select {
+	case <-self.done:
+		// Aborted before a worker took it.
+		return
+	default:
+	}
+
+	result := gost.CatchUnwind(self.f)
+
+	self.once.Do(func() {
+		self.result = result
+		close(self.done)
+	})
+}
+
+func (self *_JoinState[T]) cancel() {
+	self.once.Do(func() {
+		self.cancelled = true
+		close(self.done)
+	})
+}
+
+// Waits for the task to finish and returns its result.
+// Panics with a JoinError if the task was cancelled or panicked.
+func (self JoinHandle[T]) Await() T {
+	result := self.TryAwait()
+
+	if result.IsErr() {
+		panic(result.UnwrapErr())
+	}
+
+	return result.Unwrap()
+}
+
+// Waits for the task to finish and returns its result, or an Err of JoinError if the task was cancelled or panicked.
+//
+//	handle := gokio.SpawnOn(runtime, func() gost.I32 { panic("oh no") })
+//	gost.Assert(handle.TryAwait().IsErr())
+func (self JoinHandle[T]) TryAwait() gost.Result[T] {
+	<-self.state.done
+
+	if self.state.cancelled {
+		return gost.Err[T](JoinError{cancelled: true, panic: gost.None[gost.PanicError]()})
+	}
+
+	if self.state.result.IsErr() {
+		return gost.Err[T](JoinError{cancelled: false, panic: gost.Some(self.state.result.UnwrapErr().(gost.PanicError))})
+	}
+
+	return self.state.result
+}
+
+// Aborts the task. A task that has not started yet will never run. A task that is already running keeps running, but its result is discarded.
+// Awaiting an aborted handle returns a JoinError. Aborting a finished task does nothing.
+func (self JoinHandle[T]) Abort() {
+	self.state.cancel()
+}
+
+// Checks if the task has finished, either by returning or by being cancelled.
+func (self JoinHandle[T]) IsFinished() bool {
+	select {
+	case <-self.state.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// Returns the result of the task without waiting, or None if it has not finished yet.
+// Panics with a JoinError if the task was cancelled or panicked, as with Await.
+func (self JoinHandle[T]) Poll() gost.Option[T] {
+	if !self.IsFinished() {
+		return gost.None[T]()
+	}
+
+	return gost.Some(self.Await())
+}
+
+// impl Future for JoinHandle
+// The same as IsFinished.
+func (self JoinHandle[T]) IsReady() gost.Bool {
+	return gost.Bool(self.IsFinished())
+}
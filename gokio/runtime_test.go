@@ -0,0 +1,136 @@
+package gokio
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/myyrakle/gost"
+)
+
+func Test_Runtime_SpawnOn(t *testing.T) {
+	t.Parallel()
+
+	runtime := RuntimeBuilderNew().WorkerThreads(2).QueueSize(4).ThreadNamePrefix("test").Build().Unwrap()
+	defer runtime.Shutdown(gost.DurationFromSecs(1))
+
+	handles := []JoinHandle[gost.I32]{}
+	for i := gost.I32(0); i < 10; i++ {
+		value := i
+		handles = append(handles, SpawnOn(runtime, func() gost.I32 { return value * 2 }))
+	}
+
+	for i, handle := range handles {
+		gost.AssertEq(handle.Await(), gost.I32(i*2), "SpawnOn")
+		gost.Assert(gost.Bool(handle.IsFinished()), "IsFinished")
+	}
+
+	gost.AssertEq(BlockOn(runtime, func() gost.I32 { return 7 }), gost.I32(7), "BlockOn")
+	gost.Assert(RuntimeBuilderNew().WorkerThreads(0).Build().IsErr(), "zero workers")
+}
+
+func Test_Runtime_WorkerLimit(t *testing.T) {
+	t.Parallel()
+
+	runtime := RuntimeBuilderNew().WorkerThreads(2).Build().Unwrap()
+	defer runtime.Shutdown(gost.DurationFromSecs(1))
+
+	running := &atomic.Int64{}
+	maximum := &atomic.Int64{}
+	handles := []JoinHandle[any]{}
+
+	for i := 0; i < 8; i++ {
+		handles = append(handles, SpawnOn(runtime, func() any {
+			current := running.Add(1)
+			for {
+				previous := maximum.Load()
+				if current <= previous || maximum.CompareAndSwap(previous, current) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			running.Add(-1)
+			return nil
+		}))
+	}
+
+	for _, handle := range handles {
+		handle.Await()
+	}
+
+	gost.Assert(gost.Bool(maximum.Load() <= 2), "no more tasks run at once than there are workers")
+}
+
+func Test_JoinHandle_Abort(t *testing.T) {
+	t.Parallel()
+
+	runtime := RuntimeBuilderNew().WorkerThreads(1).Build().Unwrap()
+	defer runtime.Shutdown(gost.DurationFromSecs(1))
+
+	release := make(chan struct{})
+	blocker := SpawnOn(runtime, func() any { <-release; return nil })
+
+	ran := &atomic.Bool{}
+	queued := SpawnOn(runtime, func() any { ran.Store(true); return nil })
+	queued.Abort()
+	gost.Assert(gost.Bool(queued.IsFinished()), "an aborted task is finished")
+
+	close(release)
+	blocker.Await()
+	BlockOn(runtime, func() any { return nil })
+	gost.Assert(gost.Bool(!ran.Load()), "an aborted task never runs")
+
+	defer func() {
+		err, ok := recover().(JoinError)
+		gost.Assert(gost.Bool(ok) && err.IsCancelled(), "awaiting an aborted task panics with a JoinError")
+	}()
+	queued.Await()
+}
+
+func Test_Runtime_Shutdown(t *testing.T) {
+	t.Parallel()
+
+	runtime := RuntimeBuilderNew().WorkerThreads(1).Build().Unwrap()
+	finished := SpawnOn(runtime, func() gost.I32 { time.Sleep(5 * time.Millisecond); return 1 })
+	gost.Assert(runtime.Shutdown(gost.DurationFromSecs(1)), "Shutdown drains running tasks")
+	gost.AssertEq(finished.Await(), gost.I32(1), "drained task")
+	gost.Assert(gost.Bool(SpawnOn(runtime, func() any { return nil }).IsFinished()), "spawning after Shutdown is cancelled")
+
+	runtime = RuntimeBuilderNew().WorkerThreads(1).Build().Unwrap()
+	release := make(chan struct{})
+	defer close(release)
+
+	stuck := SpawnOn(runtime, func() any { <-release; return nil })
+	queued := SpawnOn(runtime, func() any { return nil })
+
+	gost.Assert(!runtime.Shutdown(gost.DurationFromMillis(10)), "Shutdown times out")
+	gost.Assert(gost.Bool(stuck.IsFinished() && queued.IsFinished()), "outstanding tasks are cancelled")
+}
+
+func Test_Panic(t *testing.T) {
+	t.Parallel()
+
+	future := Spawn(func() gost.I32 { panic("oh no") })
+	result := future.TryAwait()
+	gost.Assert(result.IsErr(), "TryAwait returns the panic")
+	_, ok := result.UnwrapErr().(gost.PanicError)
+	gost.Assert(gost.Bool(ok), "the error is a PanicError")
+
+	gost.AssertEq(Spawn(func() gost.I32 { return 1 }).TryAwait(), gost.Ok[gost.I32](1), "TryAwait")
+
+	runtime := RuntimeBuilderNew().WorkerThreads(1).Build().Unwrap()
+	defer runtime.Shutdown(gost.DurationFromSecs(1))
+
+	handle := SpawnOn(runtime, func() gost.I32 { panic("oh no") })
+	joinError := handle.TryAwait().UnwrapErr().(JoinError)
+	gost.Assert(joinError.IsPanic() && !joinError.IsCancelled(), "JoinError of a panic")
+	gost.Assert(gost.Bool(joinError.Panic().Unwrap().Payload() == "oh no"), "panic payload")
+
+	gost.AssertEq(BlockOn(runtime, func() gost.I32 { return 2 }), gost.I32(2), "the worker survives a panic")
+
+	defer func() {
+		_, ok := recover().(gost.PanicError)
+		gost.Assert(gost.Bool(ok), "Await propagates the panic")
+	}()
+	Spawn(func() any { panic("oh no") }).Await()
+}
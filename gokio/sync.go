@@ -0,0 +1,259 @@
+package gokio
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/myyrakle/gost"
+)
+
+// The future of a waiter on a synchronization primitive, which completes with value once the waiter is woken.
+// Select, Timeout, TryJoinAll and RunUntilCancelled cancel it when they discard its result; onCancel then closes cancelled, and gives back whatever the waiter was granted.
+// onCancel returns false if the future already completed and what it did cannot be given back, in which case it is not cancelled.
+type _WaitFuture[T any] struct {
+	ready     chan struct{}
+	cancelled chan struct{}
+	value     T
+	onCancel  func() bool
+}
+
+// impl Future for _WaitFuture
+// Panics with a JoinError if the future was cancelled.
+func (self *_WaitFuture[T]) Await() T {
+	result := self.TryAwait()
+
+	if result.IsErr() {
+		panic(result.UnwrapErr())
+	}
+
+	return result.Unwrap()
+}
+
+// impl Future for _WaitFuture
+// Returns an Err of JoinError if the future was cancelled.
+func (self *_WaitFuture[T]) TryAwait() gost.Result[T] {
+	select {
+	case <-self.ready:
+	case <-self.cancelled:
+	}
+
+	select {
+	case <-self.cancelled:
+		return gost.Err[T](JoinError{cancelled: true, panic: gost.None[gost.PanicError]()})
+	default:
+		return gost.Ok(self.value)
+	}
+}
+
+// impl Future for _WaitFuture
+func (self *_WaitFuture[T]) Poll() gost.Option[T] {
+	if !self.IsReady() {
+		return gost.None[T]()
+	}
+
+	return gost.Some(self.Await())
+}
+
+// impl Future for _WaitFuture
+func (self *_WaitFuture[T]) IsReady() gost.Bool {
+	select {
+	case <-self.ready:
+		return true
+	case <-self.cancelled:
+		return true
+	default:
+		return false
+	}
+}
+
+func (self *_WaitFuture[T]) cancel() bool {
+	return self.onCancel()
+}
+
+// Closes ch, returning false if it was already closed. Must be called with the lock that guards ch held.
+func _CloseOnce(ch chan struct{}) bool {
+	select {
+	case <-ch:
+		return false
+	default:
+		close(ch)
+		return true
+	}
+}
+
+// The error returned by TryAcquire when there are not enough permits available.
+type TryAcquireError struct{}
+
+// impl error for TryAcquireError
+func (self TryAcquireError) Error() string {
+	return "no permits available"
+}
+
+// A counting semaphore for tasks. Permits are handed out in the order they were requested, so a large AcquireMany is never starved by smaller ones.
+// Copies of a Semaphore share the same permits.
+//
+//	semaphore := gokio.SemaphoreNew(2)
+//	permit := semaphore.Acquire().Await()
+//	defer permit.Release()
+type Semaphore struct {
+	state *_SemaphoreState
+}
+
+type _SemaphoreState struct {
+	lock    sync.Mutex
+	permits gost.USize
+	waiters []*_SemaphoreWaiter
+}
+
+type _SemaphoreWaiter struct {
+	permits   gost.USize
+	ready     chan struct{}
+	cancelled chan struct{}
+	granted   bool
+}
+
+// Creates a semaphore with the given number of permits.
+func SemaphoreNew(permits gost.USize) Semaphore {
+	return Semaphore{state: &_SemaphoreState{permits: permits}}
+}
+
+// Hands out permits to waiters, in order, while there are enough. Must be called with the lock held.
+func (self *_SemaphoreState) wake() {
+	for len(self.waiters) > 0 && self.waiters[0].permits <= self.permits {
+		waiter := self.waiters[0]
+		self.waiters = self.waiters[1:]
+		self.permits -= waiter.permits
+		waiter.granted = true
+		close(waiter.ready)
+	}
+}
+
+func (self *_SemaphoreState) release(permits gost.USize) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	self.permits += permits
+	self.wake()
+}
+
+func (self *_SemaphoreState) tryAcquire(permits gost.USize) gost.Result[SemaphorePermit] {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if len(self.waiters) > 0 || self.permits < permits {
+		return gost.Err[SemaphorePermit](TryAcquireError{})
+	}
+
+	self.permits -= permits
+
+	return gost.Ok(SemaphorePermit{state: self, permits: permits, released: &atomic.Bool{}})
+}
+
+// Waits for permits, and returns a future of guard(permit) once they are acquired.
+func _Acquire[T any](state *_SemaphoreState, permits gost.USize, guard func(SemaphorePermit) T) Future[T] {
+	waiter := &_SemaphoreWaiter{permits: permits, ready: make(chan struct{}), cancelled: make(chan struct{})}
+	permit := SemaphorePermit{state: state, permits: permits, released: &atomic.Bool{}}
+
+	state.lock.Lock()
+	if len(state.waiters) == 0 && state.permits >= permits {
+		state.permits -= permits
+		waiter.granted = true
+		close(waiter.ready)
+	} else {
+		state.waiters = append(state.waiters, waiter)
+	}
+	state.lock.Unlock()
+
+	onCancel := func() bool {
+		state.lock.Lock()
+
+		if !_CloseOnce(waiter.cancelled) {
+			state.lock.Unlock()
+			return true
+		}
+
+		if waiter.granted {
+			state.lock.Unlock()
+			permit.Release()
+			return true
+		}
+
+		for i, other := range state.waiters {
+			if other == waiter {
+				state.waiters = append(state.waiters[:i], state.waiters[i+1:]...)
+				break
+			}
+		}
+
+		// A large request at the front may have held back smaller ones behind it.
+		state.wake()
+		state.lock.Unlock()
+
+		return true
+	}
+
+	return &_WaitFuture[T]{ready: waiter.ready, cancelled: waiter.cancelled, value: guard(permit), onCancel: onCancel}
+}
+
+// Acquires a permit, waiting until one is available.
+func (self Semaphore) Acquire() Future[SemaphorePermit] {
+	return self.AcquireMany(1)
+}
+
+// Acquires the given number of permits at once, waiting until they are all available.
+func (self Semaphore) AcquireMany(permits gost.USize) Future[SemaphorePermit] {
+	return _Acquire(self.state, permits, func(permit SemaphorePermit) SemaphorePermit { return permit })
+}
+
+// Acquires a permit if one is available right now, or returns an Err of TryAcquireError.
+func (self Semaphore) TryAcquire() gost.Result[SemaphorePermit] {
+	return self.state.tryAcquire(1)
+}
+
+// Acquires the given number of permits if they are all available right now, or returns an Err of TryAcquireError.
+func (self Semaphore) TryAcquireMany(permits gost.USize) gost.Result[SemaphorePermit] {
+	return self.state.tryAcquire(permits)
+}
+
+// Returns the number of permits that are not acquired.
+func (self Semaphore) AvailablePermits() gost.USize {
+	self.state.lock.Lock()
+	defer self.state.lock.Unlock()
+
+	return self.state.permits
+}
+
+// Adds permits to the semaphore, waking waiters that can now acquire theirs.
+func (self Semaphore) AddPermits(permits gost.USize) {
+	self.state.release(permits)
+}
+
+// Permits acquired from a Semaphore. They are given back by Release.
+type SemaphorePermit struct {
+	state    *_SemaphoreState
+	permits  gost.USize
+	released *atomic.Bool
+}
+
+// Returns the number of permits held.
+func (self SemaphorePermit) NumPermits() gost.USize {
+	return self.permits
+}
+
+// Gives the permits back to the semaphore. Releasing twice does nothing.
+func (self SemaphorePermit) Release() {
+	if self.released.CompareAndSwap(false, true) {
+		self.state.release(self.permits)
+	}
+}
+
+// Drops the permits without giving them back, so the semaphore has fewer permits from now on.
+func (self SemaphorePermit) Forget() {
+	self.released.Store(true)
+}
+
+func (self SemaphorePermit) checkHeld(kind string) {
+	if self.released.Load() {
+		panic(kind + " used after it was dropped")
+	}
+}
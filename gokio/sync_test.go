@@ -0,0 +1,154 @@
+package gokio
+
+import (
+	"testing"
+	"time"
+
+	"github.com/myyrakle/gost"
+)
+
+func Test_Mutex(t *testing.T) {
+	t.Parallel()
+
+	mutex := MutexNew(gost.I32(0))
+	futures := gost.VecNew[gost.Future[any]]()
+	for i := 0; i < 50; i++ {
+		futures.Push(Spawn(func() any {
+			guard := mutex.Lock().Await()
+			defer guard.Drop()
+			*guard.AsMut() += 1
+			return nil
+		}))
+	}
+	JoinAll(futures).Await()
+
+	guard := mutex.Lock().Await()
+	gost.AssertEq(guard.Get(), gost.I32(50), "Lock serializes access")
+	gost.Assert(mutex.TryLock().IsErr(), "TryLock on a locked mutex")
+
+	late := Timeout[MutexGuard[gost.I32]](mutex.Lock(), gost.DurationFromMillis(10)).Await()
+	gost.Assert(late.IsErr(), "Timeout on a locked mutex")
+
+	guard.Set(1)
+	guard.Drop()
+	guard.Drop()
+
+	gost.AssertEq(mutex.TryLock().Unwrap().Get(), gost.I32(1), "a timed out Lock does not keep the mutex locked")
+
+	defer func() {
+		gost.Assert(gost.Bool(recover() != nil), "a dropped guard cannot be used")
+	}()
+	guard.Get()
+}
+
+func Test_RwLock(t *testing.T) {
+	t.Parallel()
+
+	lock := RwLockNew(gost.String("v1"))
+	first := lock.Read().Await()
+	second := lock.TryRead().Unwrap()
+	gost.AssertEq(first.Get()+second.Get(), gost.String("v1v1"), "readers share the lock")
+	gost.Assert(lock.TryWrite().IsErr(), "TryWrite while read")
+
+	writer := lock.Write()
+	time.Sleep(10 * time.Millisecond)
+	gost.Assert(!writer.IsReady(), "Write waits for the readers")
+	gost.Assert(lock.TryRead().IsErr(), "a waiting writer holds back new readers")
+
+	first.Drop()
+	second.Drop()
+	guard := writer.Await()
+	guard.Set("v2")
+	guard.Drop()
+
+	gost.AssertEq(lock.Read().Await().Get(), gost.String("v2"), "Write")
+}
+
+func Test_Semaphore(t *testing.T) {
+	t.Parallel()
+
+	semaphore := SemaphoreNew(3)
+	permit := semaphore.Acquire().Await()
+	gost.AssertEq(semaphore.AvailablePermits(), gost.USize(2), "Acquire")
+
+	many := semaphore.AcquireMany(3)
+	gost.Assert(!many.IsReady(), "AcquireMany waits for enough permits")
+	gost.Assert(semaphore.TryAcquire().IsErr(), "permits are handed out in order")
+
+	selected := Select[SemaphorePermit](semaphore.AcquireMany(3), SemaphoreNew(1).Acquire()).Await()
+	gost.AssertEq(selected.Key, gost.USize(1), "Select")
+
+	permit.Release()
+	gost.AssertEq(many.Await().NumPermits(), gost.USize(3), "AcquireMany")
+	gost.AssertEq(semaphore.AvailablePermits(), gost.USize(0), "the cancelled AcquireMany took nothing")
+
+	many.Await().Release()
+	semaphore.TryAcquire().Unwrap().Forget()
+	gost.AssertEq(semaphore.AvailablePermits(), gost.USize(2), "Forget")
+
+	semaphore.AddPermits(1)
+	gost.AssertEq(semaphore.TryAcquireMany(3).Unwrap().NumPermits(), gost.USize(3), "AddPermits")
+}
+
+func Test_Notify(t *testing.T) {
+	t.Parallel()
+
+	notify := NotifyNew()
+	notify.NotifyOne()
+	gost.Assert(notify.Notified().IsReady(), "a stored notification completes Notified right away")
+
+	first, second := notify.Notified(), notify.Notified()
+	notify.NotifyOne()
+	first.Await()
+	gost.Assert(!second.IsReady(), "NotifyOne wakes one task")
+
+	third := notify.Notified()
+	notify.NotifyWaiters()
+	second.Await()
+	third.Await()
+
+	idle := notify.Notified()
+	gost.Assert(!idle.IsReady(), "NotifyWaiters stores nothing")
+	gost.Assert(Timeout[any](idle, gost.DurationFromMillis(1)).Await().IsErr(), "Timeout on Notified")
+
+	waiting := notify.Notified()
+	notify.NotifyOne()
+	waiting.Await()
+
+	notify.NotifyOne()
+	_Cancel[any](notify.Notified())
+	gost.Assert(notify.Notified().IsReady(), "a cancelled Notified passes its notification on")
+}
+
+func Test_Barrier(t *testing.T) {
+	t.Parallel()
+
+	barrier := BarrierNew(3)
+	futures := gost.VecNew[gost.Future[BarrierWaitResult]]()
+	for i := 0; i < 3; i++ {
+		futures.Push(barrier.Wait())
+	}
+
+	leaders := 0
+	for _, result := range JoinAll(futures).Await().AsSlice() {
+		if result.IsLeader() {
+			leaders++
+		}
+	}
+	gost.Assert(gost.Bool(leaders == 1), "exactly one leader")
+
+	first := barrier.Wait()
+	Timeout[BarrierWaitResult](barrier.Wait(), gost.DurationFromMillis(1)).Await()
+	second := barrier.Wait()
+	gost.Assert(!first.IsReady(), "a cancelled Wait does not count")
+	third := barrier.Wait()
+	first.Await()
+	second.Await()
+	gost.Assert(third.Await().IsLeader(), "the last task is the leader")
+
+	pair := BarrierNew(2)
+	released := pair.Wait()
+	pair.Wait().Await()
+	gost.Assert(gost.Bool(!_Cancel[BarrierWaitResult](released)), "a released Wait cannot be cancelled")
+	gost.Assert(gost.Bool(!released.Await().IsLeader()), "the released Wait keeps its result")
+}
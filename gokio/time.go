@@ -0,0 +1,29 @@
+package gokio
+
+import (
+	"time"
+
+	"github.com/myyrakle/gost"
+)
+
+// Waits until the specified duration has elapsed.
+//
+//	gokio.Sleep(gost.DurationFromMillis(100)).Await()
+func Sleep(duration gost.Duration) Future[any] {
+	return Spawn(func() any {
+		time.Sleep(duration.ToGoDuration())
+		return nil
+	})
+}
+
+// Waits until the specified duration has elapsed, or until token is cancelled.
+// Returns an Err of context.Canceled if the token was cancelled first.
+//
+//	if gokio.SleepCancellable(gost.DurationFromSecs(5), token).Await().IsErr() {
+//		return
+//	}
+func SleepCancellable(duration gost.Duration, token gost.CancellationToken) Future[gost.Result[any]] {
+	return Spawn(func() gost.Result[any] {
+		return gost.SleepCancellable(duration, token)
+	})
+}
@@ -0,0 +1,200 @@
+package gokio
+
+import (
+	"sync"
+
+	"github.com/myyrakle/gost"
+)
+
+type _WatchState[T any] struct {
+	lock         sync.Mutex
+	changed      _Changed
+	value        T
+	version      gost.U64
+	senderClosed bool
+	receivers    gost.USize
+}
+
+func (self *_WatchState[T]) subscribe(seen gost.U64) WatchReceiver[T] {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	self.receivers++
+
+	return WatchReceiver[T]{state: self, seen: &seen, once: &sync.Once{}}
+}
+
+// Creates a channel that holds a single value, with one sender and many receivers.
+// Receivers see only the latest value; they can wait for it to change, but values sent in between are not queued.
+//
+//	sender, receiver := gokio.WatchChannel(gost.String("starting"))
+//	sender.Send("running")
+//	gost.AssertEq(receiver.Recv().Await(), gost.Some[gost.String]("running"))
+func WatchChannel[T any](initial T) (WatchSender[T], WatchReceiver[T]) {
+	state := &_WatchState[T]{value: initial}
+
+	return WatchSender[T]{state: state}, state.subscribe(0)
+}
+
+// The sending half of a watch channel, created by WatchChannel.
+type WatchSender[T any] struct {
+	state *_WatchState[T]
+}
+
+// Replaces the value and notifies every receiver.
+// Returns an Err of SendError, carrying the value back, if every receiver has been closed; the value is not replaced.
+func (self WatchSender[T]) Send(value T) gost.Result[any] {
+	self.state.lock.Lock()
+	defer self.state.lock.Unlock()
+
+	if self.state.receivers == 0 || self.state.senderClosed {
+		return gost.Err[any](SendError[T]{value: value})
+	}
+
+	self.state.value = value
+	self.state.version++
+	self.state.changed.notify()
+
+	return gost.Ok[any](nil)
+}
+
+// Returns the current value.
+func (self WatchSender[T]) Borrow() T {
+	self.state.lock.Lock()
+	defer self.state.lock.Unlock()
+
+	return self.state.value
+}
+
+// Creates a new receiver, which considers the current value as seen.
+func (self WatchSender[T]) Subscribe() WatchReceiver[T] {
+	self.state.lock.Lock()
+	version := self.state.version
+	self.state.lock.Unlock()
+
+	return self.state.subscribe(version)
+}
+
+// Checks if every receiver has been closed.
+func (self WatchSender[T]) IsClosed() gost.Bool {
+	self.state.lock.Lock()
+	defer self.state.lock.Unlock()
+
+	return self.state.receivers == 0
+}
+
+// Closes the sender. Receivers can still read the last value, but stop waiting for changes.
+func (self WatchSender[T]) Close() {
+	self.state.lock.Lock()
+	defer self.state.lock.Unlock()
+
+	self.state.senderClosed = true
+	self.state.changed.notify()
+}
+
+// The receiving half of a watch channel, created by WatchChannel, WatchSender.Subscribe or WatchReceiver.Clone.
+type WatchReceiver[T any] struct {
+	state *_WatchState[T]
+	seen  *gost.U64
+	once  *sync.Once
+}
+
+// Returns the current value, without marking it as seen.
+func (self WatchReceiver[T]) Borrow() T {
+	self.state.lock.Lock()
+	defer self.state.lock.Unlock()
+
+	return self.state.value
+}
+
+// Returns the current value, and marks it as seen.
+func (self WatchReceiver[T]) BorrowAndUpdate() T {
+	self.state.lock.Lock()
+	defer self.state.lock.Unlock()
+
+	*self.seen = self.state.version
+	return self.state.value
+}
+
+// Checks if a value has been sent since the receiver last marked one as seen.
+func (self WatchReceiver[T]) HasChanged() gost.Bool {
+	self.state.lock.Lock()
+	defer self.state.lock.Unlock()
+
+	return *self.seen != self.state.version
+}
+
+// Waits for a value that has not been seen yet, and marks it as seen.
+// Returns an Err of RecvError if the sender is closed before that.
+// When Timeout or Select discards the result of Changed, the value is not marked as seen.
+func (self WatchReceiver[T]) Changed() Future[gost.Result[any]] {
+	return _WatchWait(self, func(value gost.Option[T]) gost.Result[any] {
+		if value.IsNone() {
+			return gost.Err[any](RecvError{lagged: 0})
+		}
+
+		return gost.Ok[any](nil)
+	})
+}
+
+// Waits for a value that has not been seen yet, marks it as seen and returns it.
+// Returns None if the sender is closed before that.
+// When Timeout or Select discards the result of Recv, the value is not marked as seen.
+func (self WatchReceiver[T]) Recv() Future[gost.Option[T]] {
+	return _WatchWait(self, func(value gost.Option[T]) gost.Option[T] { return value })
+}
+
+// Waits for a value that has not been seen yet, and marks it as seen.
+// The future completes with result of the value, or of None if the sender is closed first.
+func _WatchWait[T any, R any](self WatchReceiver[T], result func(gost.Option[T]) R) Future[R] {
+	var previous, seen gost.U64
+
+	attempt := func() gost.Option[R] {
+		previous = *self.seen
+
+		if *self.seen != self.state.version {
+			*self.seen = self.state.version
+			seen = *self.seen
+			return gost.Some(result(gost.Some(self.state.value)))
+		}
+
+		if self.state.senderClosed {
+			seen = previous
+			return gost.Some(result(gost.None[T]()))
+		}
+
+		return gost.None[R]()
+	}
+
+	undo := func(R) bool {
+		// Another receiver call may have marked a newer value as seen since.
+		if *self.seen != seen {
+			return false
+		}
+
+		*self.seen = previous
+		return true
+	}
+
+	return _ChannelOp(&self.state.lock, &self.state.changed, attempt, undo)
+}
+
+// impl Clone for WatchReceiver
+// Returns another receiver for the same channel, which has seen the same values and must be closed on its own.
+func (self WatchReceiver[T]) Clone() WatchReceiver[T] {
+	self.state.lock.Lock()
+	seen := *self.seen
+	self.state.lock.Unlock()
+
+	return self.state.subscribe(seen)
+}
+
+// Closes the receiver. Once every receiver is closed, sending fails. Closing a receiver twice does nothing.
+func (self WatchReceiver[T]) Close() {
+	self.once.Do(func() {
+		self.state.lock.Lock()
+		defer self.state.lock.Unlock()
+
+		self.state.receivers--
+	})
+}
@@ -0,0 +1,16 @@
+// The gostvet command checks calls of gost's formatting functions.
+//
+// It can be run on its own, or through go vet:
+//
+//	gostvet ./...
+//	go vet -vettool=$(which gostvet) ./...
+package main
+
+import (
+	"github.com/myyrakle/gost/gostvet"
+	"golang.org/x/tools/go/analysis/singlechecker"
+)
+
+func main() {
+	singlechecker.Main(gostvet.Analyzer)
+}
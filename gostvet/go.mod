@@ -0,0 +1,16 @@
+module github.com/myyrakle/gost/gostvet
+
+go 1.26.0
+
+require (
+	github.com/myyrakle/gost v0.0.0
+	golang.org/x/tools v0.50.0
+)
+
+require (
+	golang.org/x/mod v0.41.0 // indirect
+	golang.org/x/sync v0.23.0 // indirect
+)
+
+// The format string parser is shared with gost, from the same repository.
+replace github.com/myyrakle/gost => ../
@@ -0,0 +1,259 @@
+// Package gostvet defines an Analyzer that checks calls of gost's formatting functions.
+//
+// It reports format strings that do not match their arguments, and arguments whose
+// type cannot be formatted by the placeholder that refers to them, for calls of
+// Format, TryFormat, Print, Println, Panic, Assert, AssertEq and AssertNe.
+//
+// It shares its format string parser with gost through a replace directive,
+// so it is installed from a checkout of the repository:
+//
+//	cd gost/gostvet && go install ./cmd/gostvet
+//	go vet -vettool=$(which gostvet) ./...
+package gostvet
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/types"
+	"strconv"
+
+	"github.com/myyrakle/gost/internal/formatspec"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const gostPath = "github.com/myyrakle/gost"
+
+var Analyzer = &analysis.Analyzer{
+	Name:     "gostvet",
+	Doc:      "check that gost format strings match their arguments and that the arguments can be formatted by their placeholders",
+	URL:      "https://pkg.go.dev/github.com/myyrakle/gost/gostvet",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+// The index of the format string parameter of each checked function.
+// The formatting arguments follow it.
+var formatIndex = map[string]int{
+	"Format":    0,
+	"TryFormat": 0,
+	"Print":     0,
+	"Println":   0,
+	"Panic":     0,
+	"Assert":    1,
+	"AssertEq":  2,
+	"AssertNe":  2,
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	inspect.Preorder([]ast.Node{(*ast.CallExpr)(nil)}, func(node ast.Node) {
+		call := node.(*ast.CallExpr)
+
+		fn := calledFunction(pass, call)
+		if fn == nil {
+			return
+		}
+
+		index, ok := formatIndex[fn.Name()]
+		if !ok || len(call.Args) <= index || call.Ellipsis.IsValid() {
+			return
+		}
+
+		format := pass.TypesInfo.Types[call.Args[index]]
+		if format.Value == nil || format.Value.Kind() != constant.String {
+			return
+		}
+
+		checkCall(pass, fn.Name(), call, constant.StringVal(format.Value), call.Args[index+1:])
+	})
+
+	return nil, nil
+}
+
+// Returns the gost function called by call, or nil if it calls something else.
+func calledFunction(pass *analysis.Pass, call *ast.CallExpr) *types.Func {
+	fun := ast.Unparen(call.Fun)
+
+	// Skip explicit instantiations such as gost.AssertEq[gost.I32].
+	switch expr := fun.(type) {
+	case *ast.IndexExpr:
+		fun = expr.X
+	case *ast.IndexListExpr:
+		fun = expr.X
+	}
+
+	var ident *ast.Ident
+	switch expr := fun.(type) {
+	case *ast.Ident:
+		ident = expr
+	case *ast.SelectorExpr:
+		ident = expr.Sel
+	default:
+		return nil
+	}
+
+	fn, ok := pass.TypesInfo.Uses[ident].(*types.Func)
+	if !ok || fn.Pkg() == nil || fn.Pkg().Path() != gostPath {
+		return nil
+	}
+
+	if signature, ok := fn.Type().(*types.Signature); !ok || signature.Recv() != nil {
+		return nil
+	}
+
+	return fn
+}
+
+func checkCall(pass *analysis.Pass, name string, call *ast.CallExpr, format string, args []ast.Expr) {
+	pieces, err := formatspec.Parse(format)
+	if err != nil {
+		pass.Reportf(call.Args[formatIndex[name]].Pos(), "%s format %s: %s", name, strconv.Quote(format), err)
+		return
+	}
+
+	used := make([]bool, len(args))
+	named := false
+
+	// Marks a positional argument used, reporting it and returning false if it was not given.
+	// Named arguments are looked up in map and struct arguments, which then count as used.
+	use := func(argument formatspec.Argument) bool {
+		if argument.Name != "" {
+			named = true
+			return true
+		}
+
+		if argument.Index < 0 || argument.Index >= len(args) {
+			pass.Reportf(call.Pos(), "%s format %s refers to argument %d, but %d argument(s) were given", name, strconv.Quote(format), argument.Index, len(args))
+			return false
+		}
+
+		used[argument.Index] = true
+		return true
+	}
+
+	for _, piece := range pieces {
+		placeholder := piece.Placeholder
+		if placeholder == nil {
+			continue
+		}
+
+		for _, count := range []formatspec.Count{placeholder.Spec.Width, placeholder.Spec.Precision} {
+			if count.Argument != nil && !use(*count.Argument) {
+				return
+			}
+		}
+
+		if !use(placeholder.Argument) {
+			return
+		}
+
+		if placeholder.Argument.Name != "" {
+			continue
+		}
+
+		arg := args[placeholder.Argument.Index]
+		if problem := checkArgument(pass.TypesInfo.TypeOf(arg), placeholder.Spec.Kind); problem != "" {
+			pass.Reportf(arg.Pos(), "%s argument %s %s", name, types.ExprString(arg), problem)
+		}
+	}
+
+	if named {
+		return
+	}
+
+	for index, isUsed := range used {
+		if !isUsed {
+			pass.Reportf(args[index].Pos(), "%s format %s has %d argument(s) but argument %d is never used", name, strconv.Quote(format), len(args), index)
+			return
+		}
+	}
+}
+
+// Returns a description of why a value of type typ cannot be formatted with kind, or "" if it can.
+func checkArgument(typ types.Type, kind string) string {
+	if typ == nil || types.IsInterface(typ) {
+		return ""
+	}
+
+	// The type argument is only known at instantiation.
+	if _, ok := types.Unalias(typ).(*types.TypeParam); ok {
+		return ""
+	}
+
+	switch kind {
+	case "":
+		if hasFormatMethod(typ, "Display") || isBasic(typ, types.IsBoolean|types.IsNumeric|types.IsString) || implementsError(typ) || hasStringMethod(typ) {
+			return ""
+		}
+
+		return fmt.Sprintf("of type %s does not implement Display", typ)
+	case "?":
+		// Values that do not implement Debug are formatted with Go's %#v syntax.
+		return ""
+	case "x", "X", "b", "o":
+		if isBasic(typ, types.IsInteger) || isNamed(typ, "I128") || isNamed(typ, "U128") {
+			return ""
+		}
+
+		return fmt.Sprintf("of type %s cannot be formatted with {:%s}; only integers can", typ, kind)
+	default:
+		if isBasic(typ, types.IsInteger|types.IsFloat) || isNamed(typ, "I128") || isNamed(typ, "U128") {
+			return ""
+		}
+
+		return fmt.Sprintf("of type %s cannot be formatted with {:%s}; only numbers can", typ, kind)
+	}
+}
+
+// Reports whether typ has a method name() gost.String, in the method set used by a value of typ.
+func hasFormatMethod(typ types.Type, name string) bool {
+	object, _, _ := types.LookupFieldOrMethod(typ, false, nil, name)
+
+	method, ok := object.(*types.Func)
+	if !ok {
+		return false
+	}
+
+	signature := method.Type().(*types.Signature)
+	if signature.Params().Len() != 0 || signature.Results().Len() != 1 {
+		return false
+	}
+
+	return isNamed(signature.Results().At(0).Type(), "String")
+}
+
+func hasStringMethod(typ types.Type) bool {
+	object, _, _ := types.LookupFieldOrMethod(typ, false, nil, "String")
+
+	method, ok := object.(*types.Func)
+	if !ok {
+		return false
+	}
+
+	signature := method.Type().(*types.Signature)
+	return signature.Params().Len() == 0 && signature.Results().Len() == 1 && types.Identical(signature.Results().At(0).Type(), types.Typ[types.String])
+}
+
+func implementsError(typ types.Type) bool {
+	return types.Implements(typ, types.Universe.Lookup("error").Type().Underlying().(*types.Interface))
+}
+
+func isBasic(typ types.Type, info types.BasicInfo) bool {
+	basic, ok := typ.Underlying().(*types.Basic)
+	return ok && basic.Info()&info != 0
+}
+
+// Reports whether typ is the gost type with the given name.
+func isNamed(typ types.Type, name string) bool {
+	named, ok := types.Unalias(typ).(*types.Named)
+	if !ok {
+		return false
+	}
+
+	object := named.Obj()
+	return object.Name() == name && object.Pkg() != nil && object.Pkg().Path() == gostPath
+}
@@ -0,0 +1,12 @@
+package gostvet_test
+
+import (
+	"testing"
+
+	"github.com/myyrakle/gost/gostvet"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func Test_Analyzer(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), gostvet.Analyzer, "a")
+}
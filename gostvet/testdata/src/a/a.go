@@ -0,0 +1,33 @@
+package a
+
+import (
+	"errors"
+
+	"github.com/myyrakle/gost"
+)
+
+type point struct{ X int }
+
+func calls(a gost.I32, vec gost.Vec[gost.I32], values []any) {
+	gost.Println("{} {}", a, a)
+	gost.Println("{} {}", a) // want `Println format "{} {}" refers to argument 1, but 1 argument\(s\) were given`
+	gost.Println("{}", a, a) // want `Println format "{}" has 2 argument\(s\) but argument 1 is never used`
+	gost.Format("{1} {0}", a, 2)
+	gost.Format("{:>1$}", a, 8)
+	gost.Format("{} {x}", a, point{X: 1})
+	gost.Format("{:x} {:e}", 255, 1.5)
+	gost.Format("{}", errors.New("error"))
+	gost.Format("{:q}", a) // want `Format format "{:q}": unknown format trait 'q'`
+	gost.Format("{:.}", a) // want `Format format "{:.}": missing precision after '.'`
+	gost.Format("{", a)    // want `Format format "{": unclosed '{' at position 0`
+	gost.Format("{}", vec) // want `Format argument vec of type github.com/myyrakle/gost.Vec\[github.com/myyrakle/gost.I32\] does not implement Display`
+	gost.Format("{:?}", vec)
+	gost.Format("{:?}", point{})
+	gost.Format("{:x}", "text") // want `Format argument "text" of type string cannot be formatted with {:x}; only integers can`
+	gost.Format("{} {}", values...)
+	gost.Panic("{} is {}", a) // want `Panic format "{} is {}" refers to argument 1, but 1 argument\(s\) were given`
+}
+
+func generic[T any](value T) {
+	gost.Format("{}", value)
+}
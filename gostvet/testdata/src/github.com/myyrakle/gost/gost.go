@@ -0,0 +1,24 @@
+// Package gost is a minimal stand-in for the real package, declaring the functions and traits the analyzer knows about.
+package gost
+
+type String string
+
+type I32 int32
+
+func (self I32) Display() String { return "" }
+
+func (self I32) Debug() String { return "" }
+
+type Vec[T any] struct{ data []T }
+
+func (self Vec[T]) Debug() String { return "" }
+
+type Eq[T any] interface{ Eq(rhs T) bool }
+
+func Format(format String, params ...any) String { return format }
+
+func Println(format String, params ...any) {}
+
+func Panic(message String, args ...any) {}
+
+func AssertEq[T Eq[T]](lhs T, rhs T, args ...any) {}
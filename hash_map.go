@@ -115,8 +115,10 @@ func (self HashMap[K, V]) ContainsKey(key K) Bool {
 
 // Returns true if the map contains a value for the specified value.
 type HashMapIter[K comparable, V any] struct {
+	_IterBase[Pair[K, V]]
 	vec      Vec[Pair[K, V]]
 	position USize
+	end      USize
 }
 
 // into_iter
@@ -126,12 +128,14 @@ func (self HashMap[K, V]) IntoIter() Iterator[Pair[K, V]] {
 		vec.Push(Pair[K, V]{Key: key, Value: value})
 	}
 
-	return &HashMapIter[K, V]{vec: vec, position: 0}
+	iter := &HashMapIter[K, V]{vec: vec, position: 0, end: vec.Len()}
+	iter._IterBase = _IterBase[Pair[K, V]]{iter}
+	return iter
 }
 
 // next
 func (self *HashMapIter[K, V]) Next() Option[Pair[K, V]] {
-	if self.position >= self.vec.Len() {
+	if self.position >= self.end {
 		return None[Pair[K, V]]()
 	}
 
@@ -141,90 +145,23 @@ func (self *HashMapIter[K, V]) Next() Option[Pair[K, V]] {
 	return Some[Pair[K, V]](value)
 }
 
-// map
-func (self HashMapIter[K, V]) Map(f func(Pair[K, V]) Pair[K, V]) Iterator[Pair[K, V]] {
-	newVec := VecNew[Pair[K, V]]()
-
-	for {
-		value := self.Next()
-
-		if value.IsNone() {
-			return newVec.IntoIter()
-		}
-		newVec.Push(f(value.Unwrap()))
-	}
-}
-
-// filter
-func (self HashMapIter[K, V]) Filter(f func(Pair[K, V]) Bool) Iterator[Pair[K, V]] {
-	newVec := VecNew[Pair[K, V]]()
-
-	for {
-		value := self.Next()
-
-		if value.IsNone() {
-			return newVec.IntoIter()
-		}
-
-		unwraped := value.Unwrap()
-		if f(unwraped) {
-			newVec.Push(unwraped)
-		}
-	}
-}
-
-// fold
-func (self HashMapIter[K, V]) Fold(init Pair[K, V], f func(Pair[K, V], Pair[K, V]) Pair[K, V]) Pair[K, V] {
-	for {
-		value := self.Next()
-
-		if value.IsNone() {
-			return init
-		}
-
-		init = f(init, value.Unwrap())
-	}
-}
-
-// rev
-func (self HashMapIter[K, V]) Rev() Iterator[Pair[K, V]] {
-	newVec := VecWithLen[Pair[K, V]](self.vec.Len())
-	i := self.vec.Len() - 1
-
-	for {
-		value := self.Next()
-
-		if value.IsNone() {
-			return newVec.IntoIter()
-		}
-		newVec.AsSlice()[i] = value.Unwrap()
-		i--
+// next_back
+func (self *HashMapIter[K, V]) NextBack() Option[Pair[K, V]] {
+	if self.position >= self.end {
+		return None[Pair[K, V]]()
 	}
-}
-
-// Collect to Vec
-func (self HashMapIter[K, V]) CollectToVec() Vec[Pair[K, V]] {
-	return self.vec
-}
 
-// Collect to LinkedList
-func (self HashMapIter[K, V]) CollectToLinkedList() LinkedList[Pair[K, V]] {
-	list := LinkedListNew[Pair[K, V]]()
+	self.end--
 
-	for {
-		value := self.Next()
-
-		if value.IsNone() {
-			return list
-		}
-		list.PushBack(value.Unwrap())
-	}
+	return Some[Pair[K, V]](self.vec.GetUnchecked(self.end))
 }
 
 // An iterator visiting all keys in arbitrary order. The iterator element type is K.
 type HashMapKeys[K any] struct {
+	_IterBase[K]
 	vec      Vec[K]
 	position USize
+	end      USize
 }
 
 // An iterator visiting all keys in arbitrary order. The iterator element type is K.
@@ -241,12 +178,14 @@ func (self HashMap[K, V]) Keys() Iterator[K] {
 		vec.Push(key)
 	}
 
-	return &HashMapKeys[K]{vec: vec, position: 0}
+	iter := &HashMapKeys[K]{vec: vec, position: 0, end: vec.Len()}
+	iter._IterBase = _IterBase[K]{iter}
+	return iter
 }
 
 // next
 func (self *HashMapKeys[K]) Next() Option[K] {
-	if self.position >= self.vec.Len() {
+	if self.position >= self.end {
 		return None[K]()
 	}
 
@@ -256,90 +195,23 @@ func (self *HashMapKeys[K]) Next() Option[K] {
 	return Some[K](value)
 }
 
-// map
-func (self HashMapKeys[K]) Map(f func(K) K) Iterator[K] {
-	newVec := VecNew[K]()
-
-	for {
-		value := self.Next()
-
-		if value.IsNone() {
-			return newVec.IntoIter()
-		}
-		newVec.Push(f(value.Unwrap()))
-	}
-}
-
-// filter
-func (self HashMapKeys[K]) Filter(f func(K) Bool) Iterator[K] {
-	newVec := VecNew[K]()
-
-	for {
-		value := self.Next()
-
-		if value.IsNone() {
-			return newVec.IntoIter()
-		}
-
-		unwraped := value.Unwrap()
-		if f(unwraped) {
-			newVec.Push(unwraped)
-		}
-	}
-}
-
-// fold
-func (self HashMapKeys[K]) Fold(init K, f func(K, K) K) K {
-	for {
-		value := self.Next()
-
-		if value.IsNone() {
-			return init
-		}
-
-		init = f(init, value.Unwrap())
-	}
-}
-
-// rev
-func (self HashMapKeys[K]) Rev() Iterator[K] {
-	newVec := VecWithLen[K](self.vec.Len())
-	i := self.vec.Len() - 1
-
-	for {
-		value := self.Next()
-
-		if value.IsNone() {
-			return newVec.IntoIter()
-		}
-		newVec.AsSlice()[i] = value.Unwrap()
-		i--
+// next_back
+func (self *HashMapKeys[K]) NextBack() Option[K] {
+	if self.position >= self.end {
+		return None[K]()
 	}
-}
 
-// Collect to Vec
-func (self HashMapKeys[K]) CollectToVec() Vec[K] {
-	return self.vec
-}
-
-// Collect to LinkedList
-func (self HashMapKeys[K]) CollectToLinkedList() LinkedList[K] {
-	list := LinkedListNew[K]()
-
-	for {
-		value := self.Next()
+	self.end--
 
-		if value.IsNone() {
-			return list
-		}
-		list.PushBack(value.Unwrap())
-	}
+	return Some[K](self.vec.GetUnchecked(self.end))
 }
 
 // An iterator visiting all values in arbitrary order. The iterator element type is V.
 type HashMapValues[V any] struct {
+	_IterBase[V]
 	vec      Vec[V]
 	position USize
+	end      USize
 }
 
 // An iterator visiting all values in arbitrary order. The iterator element type is V.
@@ -349,12 +221,14 @@ func (self HashMap[K, V]) Values() Iterator[V] {
 		vec.Push(value)
 	}
 
-	return &HashMapValues[V]{vec: vec, position: 0}
+	iter := &HashMapValues[V]{vec: vec, position: 0, end: vec.Len()}
+	iter._IterBase = _IterBase[V]{iter}
+	return iter
 }
 
 // next
 func (self *HashMapValues[V]) Next() Option[V] {
-	if self.position >= self.vec.Len() {
+	if self.position >= self.end {
 		return None[V]()
 	}
 
@@ -364,84 +238,43 @@ func (self *HashMapValues[V]) Next() Option[V] {
 	return Some[V](value)
 }
 
-// map
-func (self HashMapValues[V]) Map(f func(V) V) Iterator[V] {
-	newVec := VecNew[V]()
-
-	for {
-		value := self.Next()
-
-		if value.IsNone() {
-			return newVec.IntoIter()
-		}
-		newVec.Push(f(value.Unwrap()))
-	}
-}
-
-// filter
-func (self HashMapValues[V]) Filter(f func(V) Bool) Iterator[V] {
-	newVec := VecNew[V]()
-
-	for {
-		value := self.Next()
-
-		if value.IsNone() {
-			return newVec.IntoIter()
-		}
-
-		unwraped := value.Unwrap()
-		if f(unwraped) {
-			newVec.Push(unwraped)
-		}
+// next_back
+func (self *HashMapValues[V]) NextBack() Option[V] {
+	if self.position >= self.end {
+		return None[V]()
 	}
-}
-
-// fold
-func (self HashMapValues[V]) Fold(init V, f func(V, V) V) V {
-	for {
-		value := self.Next()
 
-		if value.IsNone() {
-			return init
-		}
+	self.end--
 
-		init = f(init, value.Unwrap())
-	}
+	return Some[V](self.vec.GetUnchecked(self.end))
 }
 
-// rev
-func (self HashMapValues[V]) Rev() Iterator[V] {
-	newVec := VecWithLen[V](self.vec.Len())
-	i := self.vec.Len() - 1
+// Extends a collection with the contents of an iterator.
+//
+//	hashMap := gost.HashMapNew[gost.String, gost.I32]()
+//	pairs := gost.VecNew[gost.Pair[gost.String, gost.I32]]()
+//	pairs.Push(gost.Pair[gost.String, gost.I32]{Key: "a", Value: 1})
+//	hashMap.Extend(pairs)
+//	gost.AssertEq(hashMap.Get("a"), gost.Some[gost.I32](1))
+func (self *HashMap[K, V]) Extend(iter IntoIterator[Pair[K, V]]) {
+	iterator := iter.IntoIter()
 
 	for {
-		value := self.Next()
+		value := iterator.Next()
 
 		if value.IsNone() {
-			return newVec.IntoIter()
+			return
 		}
-		newVec.AsSlice()[i] = value.Unwrap()
-		i--
-	}
-}
 
-// Collect to Vec
-func (self HashMapValues[V]) CollectToVec() Vec[V] {
-	return self.vec
+		pair := value.Unwrap()
+		self.Insert(pair.Key, pair.Value)
+	}
 }
 
-// Collect to LinkedList
-func (self HashMapValues[V]) CollectToLinkedList() LinkedList[V] {
-	list := LinkedListNew[V]()
-
-	for {
-		value := self.Next()
-
-		if value.IsNone() {
-			return list
-		}
-		list.PushBack(value.Unwrap())
-	}
+// impl FromIterator for HashMap
+func (self *HashMap[K, V]) FromIter(iter Iterator[Pair[K, V]]) {
+	*self = HashMapNew[K, V]()
+	self.Extend(iter)
 }
 
 // impl Display for HashMap
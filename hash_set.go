@@ -330,8 +330,10 @@ func (self HashSet[K]) SymmetricDifference(other HashSet[K]) HashSet[K] {
 
 // Returns true if the set contains an element equal to the value.
 type HashSetIter[K comparable] struct {
+	_IterBase[K]
 	vec      Vec[K]
 	position USize
+	end      USize
 }
 
 // into_iter
@@ -341,12 +343,14 @@ func (self HashSet[K]) IntoIter() Iterator[K] {
 		vec.Push(key)
 	}
 
-	return &HashSetIter[K]{vec: vec, position: 0}
+	iter := &HashSetIter[K]{vec: vec, position: 0, end: vec.Len()}
+	iter._IterBase = _IterBase[K]{iter}
+	return iter
 }
 
 // next
 func (self *HashSetIter[K]) Next() Option[K] {
-	if self.position >= self.vec.Len() {
+	if self.position >= self.end {
 		return None[K]()
 	}
 
@@ -356,84 +360,43 @@ func (self *HashSetIter[K]) Next() Option[K] {
 	return Some[K](value)
 }
 
-// map
-func (self HashSetIter[K]) Map(f func(K) K) Iterator[K] {
-	newVec := VecNew[K]()
-
-	for {
-		value := self.Next()
-
-		if value.IsNone() {
-			return newVec.IntoIter()
-		}
-		newVec.Push(f(value.Unwrap()))
+// next_back
+func (self *HashSetIter[K]) NextBack() Option[K] {
+	if self.position >= self.end {
+		return None[K]()
 	}
-}
 
-// filter
-func (self HashSetIter[K]) Filter(f func(K) Bool) Iterator[K] {
-	newVec := VecNew[K]()
-
-	for {
-		value := self.Next()
+	self.end--
 
-		if value.IsNone() {
-			return newVec.IntoIter()
-		}
-
-		unwraped := value.Unwrap()
-		if f(unwraped) {
-			newVec.Push(unwraped)
-		}
-	}
+	return Some[K](self.vec.GetUnchecked(self.end))
 }
 
-// fold
-func (self HashSetIter[K]) Fold(init K, f func(K, K) K) K {
-	for {
-		value := self.Next()
-
-		if value.IsNone() {
-			return init
-		}
-
-		init = f(init, value.Unwrap())
-	}
-}
-
-// rev
-func (self HashSetIter[K]) Rev() Iterator[K] {
-	newVec := VecWithLen[K](self.vec.Len())
-	i := self.vec.Len() - 1
+// Extends a collection with the contents of an iterator.
+//
+//	set := gost.HashSetNew[gost.I32]()
+//	vec := gost.VecNew[gost.I32]()
+//	vec.Push(1)
+//	vec.Push(1)
+//	set.Extend(vec)
+//	gost.AssertEq(set.Len(), gost.USize(1))
+func (self *HashSet[K]) Extend(iter IntoIterator[K]) {
+	iterator := iter.IntoIter()
 
 	for {
-		value := self.Next()
+		value := iterator.Next()
 
 		if value.IsNone() {
-			return newVec.IntoIter()
+			return
 		}
-		newVec.AsSlice()[i] = value.Unwrap()
-		i--
-	}
-}
 
-// Collect to Vec
-func (self HashSetIter[K]) CollectToVec() Vec[K] {
-	return self.vec
+		self.Insert(value.Unwrap())
+	}
 }
 
-// Collect to LinkedList
-func (self HashSetIter[K]) CollectToLinkedList() LinkedList[K] {
-	list := LinkedListNew[K]()
-
-	for {
-		value := self.Next()
-
-		if value.IsNone() {
-			return list
-		}
-		list.PushBack(value.Unwrap())
-	}
+// impl FromIterator for HashSet
+func (self *HashSet[K]) FromIter(iter Iterator[K]) {
+	*self = HashSetNew[K]()
+	self.Extend(iter)
 }
 
 // impl Display for HashSet
@@ -0,0 +1,262 @@
+// Package formatspec parses format strings in the syntax of Rust's format! macro.
+// It is shared by gost.TryFormat, which renders format strings, and the gostvet analyzer, which checks them at compile time, so both read a format string the same way.
+package formatspec
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// The argument a placeholder, a width or a precision refers to.
+// Implicit arguments, "{}" and ".*", are numbered in the order they are taken, so every argument has an Index unless it is named.
+type Argument struct {
+	Index int    // the index into the arguments, when Name is ""
+	Name  string // the name looked up in map and struct arguments, or "" for a positional argument
+}
+
+// A width or precision, which is either a literal, taken from an argument, or absent.
+type Count struct {
+	Value    int       // the literal count, or -1 if there is none or it is taken from Argument
+	Argument *Argument // the argument holding the count, or nil
+}
+
+// The part of a placeholder after ':', such as "*^+#08.3x".
+type Spec struct {
+	Fill      rune
+	Align     rune // '<', '^', '>', or 0 for the default alignment
+	Sign      bool
+	Alternate bool
+	Zero      bool
+	Width     Count
+	Precision Count
+	Kind      string // "", "?", "x", "X", "b", "o", "e" or "E"
+}
+
+// A placeholder of a format string, such as "{0:>8}".
+type Placeholder struct {
+	Argument Argument
+	Spec     Spec
+}
+
+// A part of a format string: literal text, or a placeholder when Placeholder is not nil.
+type Piece struct {
+	Literal     string
+	Placeholder *Placeholder
+}
+
+type parser struct {
+	next int
+}
+
+// Parses a format string into literal text and placeholders. Escaped braces, "{{" and "}}", are turned into literal ones.
+// Returns an error describing the first malformed part of the format string.
+func Parse(format string) ([]Piece, error) {
+	runes := []rune(format)
+	pieces := []Piece{}
+	literal := strings.Builder{}
+	parser := parser{next: 0}
+
+	for i := 0; i < len(runes); i++ {
+		switch runes[i] {
+		case '{':
+			if i+1 < len(runes) && runes[i+1] == '{' {
+				literal.WriteRune('{')
+				i++
+				continue
+			}
+
+			end := i + 1
+			for end < len(runes) && runes[end] != '}' {
+				if runes[end] == '{' {
+					return nil, fmt.Errorf("unexpected '{' inside the placeholder opened at position %d", i)
+				}
+				end++
+			}
+
+			if end == len(runes) {
+				return nil, fmt.Errorf("unclosed '{' at position %d; use '{{' for a literal brace", i)
+			}
+
+			placeholder, err := parser.placeholder(string(runes[i+1 : end]))
+			if err != nil {
+				return nil, err
+			}
+
+			if literal.Len() > 0 {
+				pieces = append(pieces, Piece{Literal: literal.String()})
+				literal.Reset()
+			}
+
+			pieces = append(pieces, Piece{Placeholder: &placeholder})
+			i = end
+		case '}':
+			if i+1 < len(runes) && runes[i+1] == '}' {
+				literal.WriteRune('}')
+				i++
+				continue
+			}
+
+			return nil, fmt.Errorf("unmatched '}' at position %d; use '}}' for a literal brace", i)
+		default:
+			literal.WriteRune(runes[i])
+		}
+	}
+
+	if literal.Len() > 0 {
+		pieces = append(pieces, Piece{Literal: literal.String()})
+	}
+
+	return pieces, nil
+}
+
+func (self *parser) placeholder(content string) (Placeholder, error) {
+	argument, specText := content, ""
+
+	if colon := strings.IndexRune(content, ':'); colon >= 0 {
+		argument, specText = content[:colon], content[colon+1:]
+	}
+
+	// Parse the spec first: a '.*' precision takes its argument before the value, as in Rust.
+	spec, err := self.spec(specText)
+	if err != nil {
+		return Placeholder{}, err
+	}
+
+	resolved, err := self.argument(strings.TrimSpace(argument))
+	if err != nil {
+		return Placeholder{}, err
+	}
+
+	return Placeholder{Argument: resolved, Spec: spec}, nil
+}
+
+// Resolves an implicit (""), positional ("0") or named ("name") argument.
+func (self *parser) argument(name string) (Argument, error) {
+	if name == "" {
+		self.next++
+		return Argument{Index: self.next - 1}, nil
+	}
+
+	if index, err := strconv.Atoi(name); err == nil {
+		return Argument{Index: index}, nil
+	}
+
+	if !IsIdentifier(name) {
+		return Argument{}, fmt.Errorf("invalid argument name '%s'", name)
+	}
+
+	return Argument{Name: name}, nil
+}
+
+func (self *parser) spec(text string) (Spec, error) {
+	spec := Spec{Fill: ' ', Align: 0, Width: Count{Value: -1}, Precision: Count{Value: -1}}
+	runes := []rune(text)
+	i := 0
+
+	isAlign := func(r rune) bool { return r == '<' || r == '^' || r == '>' }
+
+	if len(runes) >= 2 && isAlign(runes[1]) {
+		spec.Fill, spec.Align = runes[0], runes[1]
+		i = 2
+	} else if len(runes) >= 1 && isAlign(runes[0]) {
+		spec.Align = runes[0]
+		i = 1
+	}
+
+	if i < len(runes) && (runes[i] == '+' || runes[i] == '-') {
+		spec.Sign = runes[i] == '+'
+		i++
+	}
+
+	if i < len(runes) && runes[i] == '#' {
+		spec.Alternate = true
+		i++
+	}
+
+	if i < len(runes) && runes[i] == '0' && !(i+1 < len(runes) && runes[i+1] == '$') {
+		spec.Zero = true
+		i++
+	}
+
+	width, next, err := self.count(runes, i)
+	if err != nil {
+		return spec, err
+	}
+	spec.Width, i = width, next
+
+	if i < len(runes) && runes[i] == '.' {
+		i++
+
+		if i < len(runes) && runes[i] == '*' {
+			argument, _ := self.argument("")
+			spec.Precision = Count{Value: -1, Argument: &argument}
+			i++
+		} else {
+			precision, next, err := self.count(runes, i)
+			if err != nil {
+				return spec, err
+			}
+
+			if precision.Value < 0 && precision.Argument == nil {
+				return spec, fmt.Errorf("missing precision after '.' in '{:%s}'", text)
+			}
+			spec.Precision, i = precision, next
+		}
+	}
+
+	spec.Kind = string(runes[i:])
+
+	switch spec.Kind {
+	case "", "?", "x", "X", "b", "o", "e", "E":
+		return spec, nil
+	default:
+		return spec, fmt.Errorf("unknown format trait '%s' in '{:%s}'", spec.Kind, text)
+	}
+}
+
+// Parses a width or precision at position i: an integer literal, or an argument reference followed by '$'.
+// Returns the count and the position after it.
+func (self *parser) count(runes []rune, i int) (Count, int, error) {
+	end := i
+	for end < len(runes) && (runes[end] == '_' || unicode.IsLetter(runes[end]) || unicode.IsDigit(runes[end])) {
+		end++
+	}
+
+	if end < len(runes) && runes[end] == '$' && end > i {
+		argument, err := self.argument(string(runes[i:end]))
+		if err != nil {
+			return Count{Value: -1}, i, err
+		}
+
+		return Count{Value: -1, Argument: &argument}, end + 1, nil
+	}
+
+	digits := i
+	for digits < len(runes) && runes[digits] >= '0' && runes[digits] <= '9' {
+		digits++
+	}
+
+	if digits == i {
+		return Count{Value: -1}, i, nil
+	}
+
+	value, err := strconv.Atoi(string(runes[i:digits]))
+	if err != nil {
+		return Count{Value: -1}, i, fmt.Errorf("invalid count '%s'", string(runes[i:digits]))
+	}
+
+	return Count{Value: value}, digits, nil
+}
+
+// Reports whether name can be the name of an argument: a letter or '_', followed by letters, digits and '_'.
+func IsIdentifier(name string) bool {
+	for i, r := range name {
+		if !(r == '_' || unicode.IsLetter(r) || (i > 0 && unicode.IsDigit(r))) {
+			return false
+		}
+	}
+
+	return name != ""
+}
@@ -1,9 +1,15 @@
 package gost
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
+	"io"
+	"os"
 	"sync"
+	"sync/atomic"
 	"unicode/utf8"
+	"unsafe"
 )
 
 func Println(format String, params ...any) {
@@ -15,74 +21,818 @@ func Print(format String, params ...any) {
 	fmt.Print(Format(format, params...))
 }
 
-func Format(format String, params ...any) String {
-	runes := []rune{}
+const _DEFAULT_BUF_SIZE USize = 8 * 1024
 
-	for len(string(format)) > 0 {
-		r, size := utf8.DecodeRuneInString(string(format))
-		runes = append(runes, r)
-		format = format[size:]
+// The Reader trait allows for reading bytes from a source. It corresponds to Rust's std::io::Read.
+// Read pulls some bytes from the source into buffer, returning how many bytes were read.
+// Ok(0) means the source has reached its end, or that buffer was empty.
+type Reader interface {
+	Read(buffer []Byte) Result[USize]
+}
+
+// The Writer trait allows for writing bytes to a sink. It corresponds to Rust's std::io::Write.
+// Write writes some bytes of buffer, returning how many bytes were written.
+// Flush ensures that all intermediately buffered contents reach their destination.
+type Writer interface {
+	Write(buffer []Byte) Result[USize]
+	Flush() Result[any]
+}
+
+// A Reader which has an internal buffer, allowing it to read lines and perform other ways of reading.
+// It corresponds to Rust's std::io::BufRead.
+type BufRead interface {
+	Reader
+
+	// Returns the contents of the internal buffer, filling it with more data from the inner reader if it is empty.
+	// An empty slice means the reader has reached its end.
+	FillBuf() Result[[]Byte]
+	// Tells this buffer that amount bytes have been consumed from the buffer, so they should no longer be returned by Read.
+	Consume(amount USize)
+	// Reads all bytes into buffer until the delimiter or the end is reached, including the delimiter.
+	ReadUntil(delimiter Byte, buffer *Vec[Byte]) Result[USize]
+	// Reads all bytes until a newline is reached, and appends them to buffer, including the newline.
+	ReadLine(buffer *String) Result[USize]
+	// Returns an iterator over the lines of this reader, without their newline.
+	Lines() Iterator[Result[String]]
+}
+
+// Reinterprets a []Byte as a []byte without copying.
+func _BytesToGo(buffer []Byte) []byte {
+	return unsafe.Slice((*byte)(unsafe.SliceData(buffer)), len(buffer))
+}
+
+// Reinterprets a []byte as a []Byte without copying.
+func _BytesFromGo(buffer []byte) []Byte {
+	return unsafe.Slice((*Byte)(unsafe.SliceData(buffer)), len(buffer))
+}
+
+// Writes the entire buffer into writer, calling Write until every byte is written.
+// Returns an error if the writer stops accepting bytes.
+//
+//	gost.WriteAll(gost.Stdout{}, []gost.Byte("hello\n"))
+func WriteAll(writer Writer, buffer []Byte) Result[any] {
+	for len(buffer) > 0 {
+		result := writer.Write(buffer)
+
+		if result.IsErr() {
+			return Err[any](result.UnwrapErr())
+		}
+
+		if result.Unwrap() == 0 {
+			return Err[any](io.ErrShortWrite)
+		}
+
+		buffer = buffer[result.Unwrap():]
 	}
 
-	paramsIndex := 0
+	return Ok[any](nil)
+}
 
-	buffer := ""
-	inLeftCurlyBrackets := false
-	inRightCurlyBrackets := false
+type _IoReader struct {
+	reader  io.Reader
+	pending error
+}
 
-	for _, r := range runes {
-		if r == '{' {
-			if inLeftCurlyBrackets {
-				buffer += "{"
-				inLeftCurlyBrackets = false
-				continue
-			} else {
-				inLeftCurlyBrackets = true
-				continue
-			}
+// Wraps a Go io.Reader, such as an *os.File or a net.Conn, into a Reader.
+// io.EOF is reported as Ok(0), as a Reader does at its end. An error returned together with some bytes is reported by the next Read.
+//
+//	reader := gost.BufReaderNew(gost.IoReader(strings.NewReader("line\n")))
+func IoReader(reader io.Reader) Reader {
+	return &_IoReader{reader: reader}
+}
+
+// impl Reader for _IoReader
+func (self *_IoReader) Read(buffer []Byte) Result[USize] {
+	if self.pending != nil {
+		err := self.pending
+		self.pending = nil
+		return Err[USize](err)
+	}
+
+	n, err := self.reader.Read(_BytesToGo(buffer))
+
+	if err != nil && !errors.Is(err, io.EOF) {
+		if n == 0 {
+			return Err[USize](err)
+		}
+
+		self.pending = err
+	}
+
+	return Ok(USize(n))
+}
+
+type _IoWriter struct {
+	writer io.Writer
+}
+
+// Wraps a Go io.Writer, such as an *os.File or a *bytes.Buffer, into a Writer.
+// Flush does nothing unless the io.Writer has a Flush() error method, like *bufio.Writer.
+//
+//	writer := gost.BufWriterNew(gost.IoWriter(os.Stdout))
+func IoWriter(writer io.Writer) Writer {
+	return _IoWriter{writer: writer}
+}
+
+// impl Writer for _IoWriter
+func (self _IoWriter) Write(buffer []Byte) Result[USize] {
+	n, err := self.writer.Write(_BytesToGo(buffer))
+
+	if err != nil {
+		return Err[USize](err)
+	} else {
+		return Ok(USize(n))
+	}
+}
+
+// impl Writer for _IoWriter
+func (self _IoWriter) Flush() Result[any] {
+	if flusher, ok := self.writer.(interface{ Flush() error }); ok {
+		if err := flusher.Flush(); err != nil {
+			return Err[any](err)
+		}
+	}
+
+	return Ok[any](nil)
+}
+
+type _GoReader struct {
+	reader Reader
+}
+
+// Wraps a Reader into a Go io.Reader, so it can be used with the standard library.
+func AsIoReader(reader Reader) io.Reader {
+	return _GoReader{reader: reader}
+}
+
+// impl io.Reader for _GoReader
+func (self _GoReader) Read(buffer []byte) (int, error) {
+	if len(buffer) == 0 {
+		return 0, nil
+	}
+
+	result := self.reader.Read(_BytesFromGo(buffer))
+
+	if result.IsErr() {
+		return 0, result.UnwrapErr()
+	}
+
+	if result.Unwrap() == 0 {
+		return 0, io.EOF
+	}
+
+	return int(result.Unwrap()), nil
+}
+
+type _GoWriter struct {
+	writer Writer
+}
+
+// Wraps a Writer into a Go io.Writer, so it can be used with the standard library.
+func AsIoWriter(writer Writer) io.Writer {
+	return _GoWriter{writer: writer}
+}
+
+// impl io.Writer for _GoWriter
+func (self _GoWriter) Write(buffer []byte) (int, error) {
+	result := WriteAll(self.writer, _BytesFromGo(buffer))
+
+	if result.IsErr() {
+		return 0, result.UnwrapErr()
+	}
+
+	return len(buffer), nil
+}
+
+// The BufReader struct adds buffering to any reader.
+// It can be excessively inefficient to work directly with a Reader. For example, every call to Read on a File results in a system call.
+// A BufReader performs large, infrequent reads on the underlying Reader and maintains an in-memory buffer of the results.
+type BufReader[R Reader] struct {
+	inner    R
+	buffer   []Byte
+	position USize
+	filled   USize
+}
+
+// Creates a new BufReader with a default buffer capacity. The default is currently 8 KiB.
+//
+//	reader := gost.BufReaderNew(gost.IoReader(os.Stdin))
+func BufReaderNew[R Reader](inner R) BufReader[R] {
+	return BufReaderWithCapacity(_DEFAULT_BUF_SIZE, inner)
+}
+
+// Creates a new BufReader with the specified buffer capacity.
+func BufReaderWithCapacity[R Reader](capacity USize, inner R) BufReader[R] {
+	return BufReader[R]{inner: inner, buffer: make([]Byte, capacity), position: 0, filled: 0}
+}
+
+// Gets a reference to the underlying reader.
+// It is inadvisable to directly read from the underlying reader.
+func (self *BufReader[R]) GetRef() *R {
+	return &self.inner
+}
+
+// Unwraps this BufReader, returning the underlying reader.
+// Note that any leftover data in the internal buffer is lost.
+func (self *BufReader[R]) IntoInner() R {
+	return self.inner
+}
+
+// Returns a reference to the internally buffered data.
+// Unlike FillBuf, this will not attempt to fill the buffer if it is empty.
+func (self *BufReader[R]) Buffer() []Byte {
+	return self.buffer[self.position:self.filled]
+}
+
+// Returns the number of bytes the internal buffer can hold at once.
+func (self *BufReader[R]) Capacity() USize {
+	return USize(len(self.buffer))
+}
+
+// impl Reader for BufReader
+func (self *BufReader[R]) Read(buffer []Byte) Result[USize] {
+	// Bypass the internal buffer for reads at least as large as it, when it is empty.
+	if self.position == self.filled && len(buffer) >= len(self.buffer) {
+		return self.inner.Read(buffer)
+	}
+
+	available := self.FillBuf()
+	if available.IsErr() {
+		return Err[USize](available.UnwrapErr())
+	}
+
+	n := USize(copy(buffer, available.Unwrap()))
+	self.Consume(n)
+
+	return Ok(n)
+}
+
+// impl BufRead for BufReader
+func (self *BufReader[R]) FillBuf() Result[[]Byte] {
+	if self.position >= self.filled {
+		result := self.inner.Read(self.buffer)
+
+		if result.IsErr() {
+			return Err[[]Byte](result.UnwrapErr())
+		}
+
+		self.position = 0
+		self.filled = result.Unwrap()
+	}
+
+	return Ok(self.buffer[self.position:self.filled])
+}
+
+// impl BufRead for BufReader
+func (self *BufReader[R]) Consume(amount USize) {
+	self.position += amount
+
+	if self.position > self.filled {
+		self.position = self.filled
+	}
+}
+
+// impl BufRead for BufReader
+//
+//	reader := gost.BufReaderNew(gost.IoReader(strings.NewReader("a,b")))
+//	buffer := gost.VecNew[gost.Byte]()
+//	gost.AssertEq(reader.ReadUntil(',', &buffer), gost.Ok[gost.USize](2))
+func (self *BufReader[R]) ReadUntil(delimiter Byte, buffer *Vec[Byte]) Result[USize] {
+	return _ReadUntil(self, delimiter, buffer)
+}
+
+// impl BufRead for BufReader
+// Returns Ok(0) at the end of the reader. The newline, if any, is kept at the end of buffer.
+//
+//	reader := gost.BufReaderNew(gost.IoReader(strings.NewReader("a b\n\nc")))
+//	line := gost.String("")
+//	reader.ReadLine(&line)
+//	gost.AssertEq(line, gost.String("a b\n"))
+func (self *BufReader[R]) ReadLine(buffer *String) Result[USize] {
+	return _ReadLine(self, buffer)
+}
+
+// impl BufRead for BufReader
+//
+//	reader := gost.BufReaderNew(gost.IoReader(strings.NewReader("a\nb\n")))
+//	gost.AssertEq(reader.Lines().Count(), gost.USize(2))
+func (self *BufReader[R]) Lines() Iterator[Result[String]] {
+	return _LinesNew(self)
+}
+
+// Reads all bytes until the end of the reader, appending them to buffer.
+//
+//	reader := gost.BufReaderNew(gost.IoReader(strings.NewReader("abc")))
+//	buffer := gost.VecNew[gost.Byte]()
+//	gost.AssertEq(reader.ReadToEnd(&buffer), gost.Ok[gost.USize](3))
+func (self *BufReader[R]) ReadToEnd(buffer *Vec[Byte]) Result[USize] {
+	return _ReadToEnd(self, buffer)
+}
+
+// Reads all bytes until the end of the reader, appending them to buffer as UTF-8.
+// If the data is not valid UTF-8, an error is returned and buffer is unchanged.
+func (self *BufReader[R]) ReadToString(buffer *String) Result[USize] {
+	return _ReadToString(self, buffer)
+}
+
+func _ReadUntil(reader BufRead, delimiter Byte, buffer *Vec[Byte]) Result[USize] {
+	read := USize(0)
+
+	for {
+		available := reader.FillBuf()
+		if available.IsErr() {
+			return Err[USize](available.UnwrapErr())
+		}
+
+		chunk := available.Unwrap()
+		if len(chunk) == 0 {
+			return Ok(read)
+		}
+
+		if index := bytes.IndexByte(_BytesToGo(chunk), byte(delimiter)); index >= 0 {
+			buffer.data = append(buffer.data, chunk[:index+1]...)
+			reader.Consume(USize(index + 1))
+
+			return Ok(read + USize(index+1))
+		}
+
+		buffer.data = append(buffer.data, chunk...)
+		reader.Consume(USize(len(chunk)))
+		read += USize(len(chunk))
+	}
+}
+
+func _ReadLine(reader BufRead, buffer *String) Result[USize] {
+	line := VecNew[Byte]()
+	result := _ReadUntil(reader, '\n', &line)
+
+	if result.IsErr() {
+		return result
+	}
+
+	if !utf8.Valid(_BytesToGo(line.data)) {
+		return Err[USize](errors.New("stream did not contain valid UTF-8"))
+	}
+
+	*buffer += String(line.data)
+
+	return result
+}
+
+func _ReadToEnd(reader BufRead, buffer *Vec[Byte]) Result[USize] {
+	read := USize(0)
+
+	for {
+		available := reader.FillBuf()
+		if available.IsErr() {
+			return Err[USize](available.UnwrapErr())
+		}
+
+		chunk := available.Unwrap()
+		if len(chunk) == 0 {
+			return Ok(read)
+		}
+
+		buffer.data = append(buffer.data, chunk...)
+		reader.Consume(USize(len(chunk)))
+		read += USize(len(chunk))
+	}
+}
+
+func _ReadToString(reader BufRead, buffer *String) Result[USize] {
+	data := VecNew[Byte]()
+	result := _ReadToEnd(reader, &data)
+
+	if result.IsErr() {
+		return result
+	}
+
+	if !utf8.Valid(_BytesToGo(data.data)) {
+		return Err[USize](errors.New("stream did not contain valid UTF-8"))
+	}
+
+	*buffer += String(data.data)
+
+	return result
+}
+
+// An iterator over the lines of a BufRead. Each line is yielded without its trailing "\n" or "\r\n".
+// This is created by BufRead.Lines and Stdin.Lines.
+type LinesIter struct {
+	_IterBase[Result[String]]
+	reader _LineReader
+}
+
+type _LineReader interface {
+	ReadLine(buffer *String) Result[USize]
+}
+
+func _LinesNew(reader _LineReader) Iterator[Result[String]] {
+	iter := &LinesIter{reader: reader}
+	iter._IterBase = _IterBase[Result[String]]{iter}
+	return iter
+}
+
+// next
+func (self *LinesIter) Next() Option[Result[String]] {
+	line := String("")
+	result := self.reader.ReadLine(&line)
+
+	if result.IsErr() {
+		return Some(Err[String](result.UnwrapErr()))
+	}
+
+	if result.Unwrap() == 0 {
+		return None[Result[String]]()
+	}
+
+	if len(line) > 0 && line[len(line)-1] == '\n' {
+		line = line[:len(line)-1]
+
+		if len(line) > 0 && line[len(line)-1] == '\r' {
+			line = line[:len(line)-1]
+		}
+	}
+
+	return Some(Ok(line))
+}
+
+// Wraps a writer and buffers its output.
+// It can be excessively inefficient to work directly with something that implements Writer. For example, every call to Write on a File results in a system call.
+// A BufWriter keeps an in-memory buffer of data and writes it to an underlying writer in large, infrequent batches.
+// Call Flush before the BufWriter is discarded, or buffered data is lost.
+type BufWriter[W Writer] struct {
+	inner  W
+	buffer []Byte
+}
+
+// Creates a new BufWriter with a default buffer capacity. The default is currently 8 KiB.
+//
+//	writer := gost.BufWriterNew(gost.Stdout{})
+//	writer.WriteAll([]gost.Byte("hello\n"))
+//	writer.Flush()
+func BufWriterNew[W Writer](inner W) BufWriter[W] {
+	return BufWriterWithCapacity(_DEFAULT_BUF_SIZE, inner)
+}
+
+// Creates a new BufWriter with at least the specified buffer capacity.
+func BufWriterWithCapacity[W Writer](capacity USize, inner W) BufWriter[W] {
+	return BufWriter[W]{inner: inner, buffer: make([]Byte, 0, capacity)}
+}
+
+// Gets a reference to the underlying writer.
+func (self *BufWriter[W]) GetRef() *W {
+	return &self.inner
+}
+
+// Unwraps this BufWriter, returning the underlying writer.
+// The buffer is written out before returning the writer.
+func (self *BufWriter[W]) IntoInner() Result[W] {
+	result := self.flushBuf()
+
+	if result.IsErr() {
+		return Err[W](result.UnwrapErr())
+	} else {
+		return Ok(self.inner)
+	}
+}
+
+// Returns a reference to the internally buffered data.
+func (self *BufWriter[W]) Buffer() []Byte {
+	return self.buffer
+}
+
+// Returns the number of bytes the internal buffer can hold without flushing.
+func (self *BufWriter[W]) Capacity() USize {
+	return USize(cap(self.buffer))
+}
+
+// impl Writer for BufWriter
+func (self *BufWriter[W]) Write(buffer []Byte) Result[USize] {
+	if len(self.buffer)+len(buffer) > cap(self.buffer) {
+		if result := self.flushBuf(); result.IsErr() {
+			return Err[USize](result.UnwrapErr())
 		}
+	}
+
+	// Writes at least as large as the buffer go straight to the inner writer.
+	if len(buffer) >= cap(self.buffer) {
+		return self.inner.Write(buffer)
+	}
+
+	self.buffer = append(self.buffer, buffer...)
+
+	return Ok(USize(len(buffer)))
+}
+
+// Attempts to write an entire buffer into this writer.
+func (self *BufWriter[W]) WriteAll(buffer []Byte) Result[any] {
+	return WriteAll(self, buffer)
+}
+
+// impl Writer for BufWriter
+// Writes out the buffered data, then flushes the inner writer.
+func (self *BufWriter[W]) Flush() Result[any] {
+	if result := self.flushBuf(); result.IsErr() {
+		return result
+	}
+
+	return self.inner.Flush()
+}
 
-		if r == '}' {
-			if inRightCurlyBrackets {
-				buffer += "}"
-				inRightCurlyBrackets = false
-				continue
-			} else if inLeftCurlyBrackets {
-				param := params[paramsIndex]
+// Writes the buffered data to the inner writer. Data that could not be written stays buffered.
+func (self *BufWriter[W]) flushBuf() Result[any] {
+	written := 0
 
-				display := castToDisplay(param).Unwrap()
-				buffer += string(display.Display())
+	for written < len(self.buffer) {
+		result := self.inner.Write(self.buffer[written:])
 
-				paramsIndex++
-				inLeftCurlyBrackets = false
-				continue
+		if result.IsErr() || result.Unwrap() == 0 {
+			self.buffer = self.buffer[:copy(self.buffer, self.buffer[written:])]
+
+			if result.IsErr() {
+				return Err[any](result.UnwrapErr())
 			} else {
-				inRightCurlyBrackets = true
-				continue
+				return Err[any](io.ErrShortWrite)
 			}
 		}
 
-		buffer += string(r)
+		written += int(result.Unwrap())
 	}
 
-	return String(buffer)
+	self.buffer = self.buffer[:0]
+
+	return Ok[any](nil)
+}
+
+// Wraps a writer and buffers output to it, flushing whenever a newline is written.
+// This is useful for output that is read by a human as it is produced, like a log or a terminal.
+type LineWriter[W Writer] struct {
+	inner BufWriter[W]
 }
 
-type Stdin struct {
-	lock sync.Mutex
+// Creates a new LineWriter.
+//
+//	writer := gost.LineWriterNew(gost.Stdout{})
+//	writer.WriteAll([]gost.Byte("shown immediately\n"))
+func LineWriterNew[W Writer](inner W) LineWriter[W] {
+	return LineWriter[W]{inner: BufWriterWithCapacity(1024, inner)}
+}
+
+// Creates a new LineWriter with at least the specified buffer capacity.
+func LineWriterWithCapacity[W Writer](capacity USize, inner W) LineWriter[W] {
+	return LineWriter[W]{inner: BufWriterWithCapacity(capacity, inner)}
+}
+
+// Gets a reference to the underlying writer.
+func (self *LineWriter[W]) GetRef() *W {
+	return self.inner.GetRef()
+}
+
+// Unwraps this LineWriter, returning the underlying writer.
+// The internal buffer is written out before returning the writer.
+func (self *LineWriter[W]) IntoInner() Result[W] {
+	return self.inner.IntoInner()
+}
+
+// impl Writer for LineWriter
+// Everything up to and including the last newline of buffer is written out immediately; the rest is buffered.
+func (self *LineWriter[W]) Write(buffer []Byte) Result[USize] {
+	newline := bytes.LastIndexByte(_BytesToGo(buffer), '\n')
+
+	if newline < 0 {
+		return self.inner.Write(buffer)
+	}
+
+	if result := self.inner.WriteAll(buffer[:newline+1]); result.IsErr() {
+		return Err[USize](result.UnwrapErr())
+	}
+
+	if result := self.inner.flushBuf(); result.IsErr() {
+		return Err[USize](result.UnwrapErr())
+	}
+
+	if result := self.inner.WriteAll(buffer[newline+1:]); result.IsErr() {
+		return Err[USize](result.UnwrapErr())
+	}
+
+	return Ok(USize(len(buffer)))
+}
+
+// Attempts to write an entire buffer into this writer.
+func (self *LineWriter[W]) WriteAll(buffer []Byte) Result[any] {
+	return WriteAll(self, buffer)
+}
+
+// impl Writer for LineWriter
+func (self *LineWriter[W]) Flush() Result[any] {
+	return self.inner.Flush()
+}
+
+// A handle to the standard input stream of a process.
+// All handles share one global buffer, so data read ahead by one handle is not lost to another.
+//
+//	stdin := gost.Stdin{}
+//	line := gost.String("")
+//	stdin.ReadLine(&line)
+type Stdin struct{}
+
+var _stdin struct {
+	once   sync.Once
+	lock   sync.Mutex
+	reader BufReader[Reader]
+}
+
+func _StdinReader() *BufReader[Reader] {
+	_stdin.once.Do(func() {
+		_stdin.reader = BufReaderNew(IoReader(os.Stdin))
+	})
+
+	return &_stdin.reader
+}
+
+// Reads the standard input from reader instead of os.Stdin, until the returned function restores the previous reader.
+// os.Stdin is bound on first use, so tests replace the reader with this rather than by assigning os.Stdin.
+func _ReplaceStdin(reader Reader) func() {
+	_StdinReader()
+
+	_stdin.lock.Lock()
+	previous := _stdin.reader
+	_stdin.reader = BufReaderNew(reader)
+	_stdin.lock.Unlock()
+
+	return func() {
+		_stdin.lock.Lock()
+		defer _stdin.lock.Unlock()
+
+		_stdin.reader = previous
+	}
 }
 
 // Locks this handle to the standard input stream, returning a readable guard.
-func (self *Stdin) Lock() *sync.Mutex {
-	return &self.lock
+// Other handles wait until the guard is dropped, so FillBuf and Consume can be used on it without another reader getting in between.
+//
+//	lock := gost.Stdin{}.Lock()
+//	defer lock.Drop()
+//	available := lock.FillBuf().Unwrap()
+//	lock.Consume(gost.USize(len(available)))
+func (self Stdin) Lock() StdinLock {
+	_stdin.lock.Lock()
+	return StdinLock{released: &atomic.Bool{}}
+}
+
+// impl Reader for Stdin
+func (self Stdin) Read(buffer []Byte) Result[USize] {
+	_stdin.lock.Lock()
+	defer _stdin.lock.Unlock()
+
+	return _StdinReader().Read(buffer)
+}
+
+// Locks this handle and reads all bytes into buffer until the delimiter or the end is reached, including the delimiter.
+func (self Stdin) ReadUntil(delimiter Byte, buffer *Vec[Byte]) Result[USize] {
+	_stdin.lock.Lock()
+	defer _stdin.lock.Unlock()
+
+	return _StdinReader().ReadUntil(delimiter, buffer)
 }
 
 // Locks this handle and reads a line of input, appending it to the specified buffer.
-func (self *Stdin) ReadLine(buffer *String) {
-	self.lock.Lock()
-	defer self.lock.Unlock()
+// The whole line is read, including spaces, and the newline is kept. Empty lines read as "\n"; Ok(0) means the input has ended.
+//
+//	line := gost.String("")
+//	gost.Stdin{}.ReadLine(&line)
+func (self Stdin) ReadLine(buffer *String) Result[USize] {
+	_stdin.lock.Lock()
+	defer _stdin.lock.Unlock()
+
+	return _StdinReader().ReadLine(buffer)
+}
+
+// Returns an iterator over the lines of the input, without their newline.
+// Each line is read under the lock, so lines are never split between concurrent readers.
+//
+//	for line := gost.Stdin{}.Lines(); ; {
+//		next := line.Next()
+//		if next.IsNone() {
+//			break
+//		}
+//		gost.Println("{}", next.Unwrap().Unwrap())
+//	}
+func (self Stdin) Lines() Iterator[Result[String]] {
+	return _LinesNew(self)
+}
+
+// Locks this handle and reads all input until its end, appending it to buffer.
+func (self Stdin) ReadToEnd(buffer *Vec[Byte]) Result[USize] {
+	_stdin.lock.Lock()
+	defer _stdin.lock.Unlock()
+
+	return _StdinReader().ReadToEnd(buffer)
+}
+
+// Locks this handle and reads all input until its end, appending it to buffer as UTF-8.
+func (self Stdin) ReadToString(buffer *String) Result[USize] {
+	_stdin.lock.Lock()
+	defer _stdin.lock.Unlock()
+
+	return _StdinReader().ReadToString(buffer)
+}
+
+// A locked handle to the standard input stream, returned by Stdin.Lock. It is unlocked by Drop, after which it cannot be used.
+type StdinLock struct {
+	released *atomic.Bool
+}
+
+func (self StdinLock) reader() *BufReader[Reader] {
+	if self.released.Load() {
+		panic("StdinLock used after it was dropped")
+	}
+
+	return _StdinReader()
+}
+
+// impl Reader for StdinLock
+func (self StdinLock) Read(buffer []Byte) Result[USize] {
+	return self.reader().Read(buffer)
+}
+
+// impl BufRead for StdinLock
+// The returned slice is only valid until the next call on this handle, and until it is dropped.
+func (self StdinLock) FillBuf() Result[[]Byte] {
+	return self.reader().FillBuf()
+}
+
+// impl BufRead for StdinLock
+func (self StdinLock) Consume(amount USize) {
+	self.reader().Consume(amount)
+}
+
+// impl BufRead for StdinLock
+func (self StdinLock) ReadUntil(delimiter Byte, buffer *Vec[Byte]) Result[USize] {
+	return self.reader().ReadUntil(delimiter, buffer)
+}
+
+// impl BufRead for StdinLock
+func (self StdinLock) ReadLine(buffer *String) Result[USize] {
+	return self.reader().ReadLine(buffer)
+}
+
+// impl BufRead for StdinLock
+func (self StdinLock) Lines() Iterator[Result[String]] {
+	return _LinesNew(self)
+}
+
+// Reads all input until its end, appending it to buffer.
+func (self StdinLock) ReadToEnd(buffer *Vec[Byte]) Result[USize] {
+	return self.reader().ReadToEnd(buffer)
+}
+
+// Reads all input until its end, appending it to buffer as UTF-8.
+func (self StdinLock) ReadToString(buffer *String) Result[USize] {
+	return self.reader().ReadToString(buffer)
+}
+
+// Unlocks the standard input stream. Dropping twice does nothing.
+func (self StdinLock) Drop() {
+	if self.released.CompareAndSwap(false, true) {
+		_stdin.lock.Unlock()
+	}
+}
+
+// A handle to the global standard output stream of the current process.
+// Writes go straight to the stream, in order with Print and Println; wrap it in a BufWriter or LineWriter to buffer them.
+type Stdout struct{}
+
+// impl Writer for Stdout
+func (self Stdout) Write(buffer []Byte) Result[USize] {
+	return IoWriter(os.Stdout).Write(buffer)
+}
+
+// Attempts to write an entire buffer into the standard output stream.
+func (self Stdout) WriteAll(buffer []Byte) Result[any] {
+	return WriteAll(self, buffer)
+}
+
+// impl Writer for Stdout
+func (self Stdout) Flush() Result[any] {
+	return Ok[any](nil)
+}
+
+// A handle to the standard error stream of a process. It is not buffered.
+type Stderr struct{}
+
+// impl Writer for Stderr
+func (self Stderr) Write(buffer []Byte) Result[USize] {
+	return IoWriter(os.Stderr).Write(buffer)
+}
+
+// Attempts to write an entire buffer into the standard error stream.
+func (self Stderr) WriteAll(buffer []Byte) Result[any] {
+	return WriteAll(self, buffer)
+}
 
-	var line string
-	fmt.Scanln(&line)
-	*buffer = String(line)
+// impl Writer for Stderr
+func (self Stderr) Flush() Result[any] {
+	return Ok[any](nil)
 }
@@ -0,0 +1,167 @@
+package gost
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func Test_BufReader_ReadLine(t *testing.T) {
+	t.Parallel()
+
+	reader := BufReaderWithCapacity(4, IoReader(strings.NewReader("hello world\n\nlast")))
+
+	line := String("")
+	AssertEq(reader.ReadLine(&line), Ok[USize](12), "line with spaces")
+	AssertEq(line, String("hello world\n"), "line with spaces")
+
+	line = ""
+	AssertEq(reader.ReadLine(&line), Ok[USize](1), "empty line")
+	AssertEq(line, String("\n"), "empty line")
+
+	AssertEq(reader.ReadLine(&line), Ok[USize](4), "line without newline appends")
+	AssertEq(line, String("\nlast"), "line without newline appends")
+
+	AssertEq(reader.ReadLine(&line), Ok[USize](0), "end of reader")
+}
+
+func Test_BufReader_Lines(t *testing.T) {
+	t.Parallel()
+
+	reader := BufReaderNew(IoReader(strings.NewReader("a b\r\n\nc\n")))
+	lines := VecNew[String]()
+
+	for iter := reader.Lines(); ; {
+		next := iter.Next()
+		if next.IsNone() {
+			break
+		}
+		lines.Push(next.Unwrap().Unwrap())
+	}
+
+	expected := VecNew[String]()
+	expected.Push("a b")
+	expected.Push("")
+	expected.Push("c")
+	AssertEq(lines, expected, "lines")
+}
+
+func Test_BufReader_ReadUntil(t *testing.T) {
+	t.Parallel()
+
+	reader := BufReaderWithCapacity(2, IoReader(strings.NewReader("key=value;rest")))
+	buffer := VecNew[Byte]()
+
+	AssertEq(reader.ReadUntil(';', &buffer), Ok[USize](10), "until delimiter")
+	AssertEq(String(buffer.AsSlice()), String("key=value;"), "until delimiter")
+
+	buffer = VecNew[Byte]()
+	AssertEq(reader.ReadToEnd(&buffer), Ok[USize](4), "to end")
+	AssertEq(String(buffer.AsSlice()), String("rest"), "to end")
+
+	text := String("")
+	AssertEq(reader.ReadToString(&text), Ok[USize](0), "to string at end")
+}
+
+func Test_BufReader_Read(t *testing.T) {
+	t.Parallel()
+
+	reader := BufReaderWithCapacity(4, IoReader(strings.NewReader("abcdefgh")))
+	small := make([]Byte, 2)
+
+	AssertEq(reader.Read(small), Ok[USize](2), "read from buffer")
+	AssertEq(String(small), String("ab"), "read from buffer")
+	AssertEq(String(reader.Buffer()), String("cd"), "rest of the buffer")
+
+	large := make([]Byte, 8)
+	AssertEq(reader.Read(large), Ok[USize](2), "buffered bytes come first")
+	AssertEq(reader.Read(large), Ok[USize](4), "large read bypasses the buffer")
+	AssertEq(String(large[:4]), String("efgh"), "large read bypasses the buffer")
+	AssertEq(reader.Read(large), Ok[USize](0), "end of reader")
+}
+
+func Test_BufWriter(t *testing.T) {
+	t.Parallel()
+
+	output := bytes.Buffer{}
+	writer := BufWriterWithCapacity(8, IoWriter(&output))
+
+	Assert(writer.WriteAll([]Byte("abc")).IsOk(), "buffered write")
+	Assert(Bool(output.Len() == 0), "nothing is written before a flush")
+
+	Assert(writer.WriteAll([]Byte("defgh")).IsOk(), "filling the buffer")
+	Assert(writer.WriteAll([]Byte("i")).IsOk(), "overflowing the buffer")
+	AssertEq(String(output.String()), String("abcdefgh"), "full buffer is written out")
+
+	Assert(writer.Flush().IsOk(), "flush")
+	AssertEq(String(output.String()), String("abcdefghi"), "flush")
+
+	Assert(writer.WriteAll([]Byte("0123456789")).IsOk(), "large write")
+	AssertEq(String(output.String()), String("abcdefghi0123456789"), "large write goes straight through")
+}
+
+func Test_LineWriter(t *testing.T) {
+	t.Parallel()
+
+	output := bytes.Buffer{}
+	writer := LineWriterNew(IoWriter(&output))
+
+	Assert(writer.WriteAll([]Byte("first")).IsOk(), "partial line")
+	Assert(Bool(output.Len() == 0), "partial line is buffered")
+
+	Assert(writer.WriteAll([]Byte(" line\nsecond")).IsOk(), "complete line")
+	AssertEq(String(output.String()), String("first line\n"), "complete line is written out")
+
+	Assert(writer.Flush().IsOk(), "flush")
+	AssertEq(String(output.String()), String("first line\nsecond"), "flush")
+}
+
+func Test_AsIoReader_AsIoWriter(t *testing.T) {
+	t.Parallel()
+
+	output := bytes.Buffer{}
+	_, err := output.ReadFrom(AsIoReader(IoReader(strings.NewReader("round trip"))))
+	Assert(Bool(err == nil), "ReadFrom")
+
+	converted := strings.Builder{}
+	_, err = AsIoWriter(IoWriter(&converted)).Write(output.Bytes())
+	Assert(Bool(err == nil), "Write")
+	AssertEq(String(converted.String()), String("round trip"), "round trip")
+}
+
+type failingReader struct{}
+
+func (failingReader) Read(buffer []byte) (int, error) {
+	return copy(buffer, "partial"), errors.New("disk failure")
+}
+
+func Test_IoReader_PartialError(t *testing.T) {
+	t.Parallel()
+
+	reader := IoReader(failingReader{})
+	buffer := make([]Byte, 16)
+
+	AssertEq(reader.Read(buffer), Ok[USize](7), "the bytes read before the error")
+	AssertEq(String(buffer[:7]), String("partial"), "partial contents")
+	Assert(reader.Read(buffer).IsErr(), "the error is reported by the next Read")
+}
+
+func Test_StdinLock(t *testing.T) {
+	restore := _ReplaceStdin(IoReader(strings.NewReader("first\nsecond\n")))
+	defer restore()
+
+	lock := Stdin{}.Lock()
+	available := lock.FillBuf().Unwrap()
+	AssertEq(String(available[:6]), String("first\n"), "FillBuf")
+	lock.Consume(6)
+
+	line := String("")
+	AssertEq(lock.ReadLine(&line), Ok[USize](7), "ReadLine on the lock does not lock again")
+	AssertEq(line, String("second\n"), "ReadLine")
+	lock.Drop()
+	lock.Drop()
+
+	line = String("")
+	AssertEq(Stdin{}.ReadLine(&line), Ok[USize](0), "Stdin is unlocked by Drop")
+}
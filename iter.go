@@ -1,5 +1,10 @@
 package gost
 
+import (
+	"fmt"
+	"reflect"
+)
+
 type Iterator[T any] interface {
 	Next() Option[T]
 	Map(f func(T) T) Iterator[T]
@@ -8,8 +13,1045 @@ type Iterator[T any] interface {
 	Rev() Iterator[T]
 	CollectToVec() Vec[T]
 	CollectToLinkedList() LinkedList[T]
+	IntoIter() Iterator[T]
+
+	Count() USize
+	Last() Option[T]
+	Nth(n USize) Option[T]
+	Any(f func(T) Bool) Bool
+	All(f func(T) Bool) Bool
+	Find(predicate func(T) Bool) Option[T]
+	Position(predicate func(T) Bool) Option[USize]
+	Min() Option[T]
+	Max() Option[T]
+	MinBy(compare func(T, T) Ordering) Option[T]
+	MaxBy(compare func(T, T) Ordering) Option[T]
+	Sum() T
+	Product() T
+
+	Take(n USize) Iterator[T]
+	Skip(n USize) Iterator[T]
+	TakeWhile(predicate func(T) Bool) Iterator[T]
+	SkipWhile(predicate func(T) Bool) Iterator[T]
+	StepBy(step USize) Iterator[T]
+	Chain(other Iterator[T]) Iterator[T]
+	Peekable() *PeekableIter[T]
+	Cycle() Iterator[T]
 }
 
 type IntoIterator[T any] interface {
 	IntoIter() Iterator[T]
 }
+
+// Conversion from an Iterator.
+// By implementing FromIterator for a type, you define how it will be created from an iterator.
+// FromIter replaces the contents of the receiver with the elements of iter.
+type FromIterator[T any] interface {
+	FromIter(iter Iterator[T])
+}
+
+// Transforms an iterator into a collection.
+// C is the collection type, which must implement FromIterator[T] through its pointer.
+//
+//	vec := gost.VecNew[gost.I32]()
+//	vec.Push(1)
+//	vec.Push(1)
+//	set := gost.Collect[gost.HashSet[gost.I32]](vec.IntoIter())
+//	gost.AssertEq(set.Len(), gost.USize(1))
+func Collect[C any, T any, PC interface {
+	*C
+	FromIterator[T]
+}](iter Iterator[T]) C {
+	var collection C
+	PC(&collection).FromIter(iter)
+	return collection
+}
+
+// An iterator able to yield elements from both ends.
+// Rev on a DoubleEndedIterator is lazy and does not allocate.
+type DoubleEndedIterator[T any] interface {
+	Iterator[T]
+	NextBack() Option[T]
+}
+
+// _IterBase provides the provided methods of Iterator on top of Next.
+// Every iterator embeds it and points it back at itself, so adapters pull lazily from the outer Next.
+type _IterBase[T any] struct {
+	iter Iterator[T]
+}
+
+// map
+func (self _IterBase[T]) Map(f func(T) T) Iterator[T] {
+	return MapTo[T, T](self.iter, f)
+}
+
+// filter
+func (self _IterBase[T]) Filter(f func(T) Bool) Iterator[T] {
+	iter := &FilterIter[T]{iter: self.iter, f: f}
+	iter._IterBase = _IterBase[T]{iter}
+	return iter
+}
+
+// fold
+func (self _IterBase[T]) Fold(init T, f func(T, T) T) T {
+	return FoldTo[T, T](self.iter, init, f)
+}
+
+// rev
+// If the iterator is not a DoubleEndedIterator, all of the remaining elements are collected first and yielded back to front, so the iterator must be finite.
+func (self _IterBase[T]) Rev() Iterator[T] {
+	if doubleEnded, ok := self.iter.(DoubleEndedIterator[T]); ok {
+		iter := &RevIter[T]{iter: doubleEnded}
+		iter._IterBase = _IterBase[T]{iter}
+		return iter
+	}
+
+	return self.CollectToVec().IntoIter().Rev()
+}
+
+// collect to Vec
+func (self _IterBase[T]) CollectToVec() Vec[T] {
+	vec := VecNew[T]()
+	for {
+		value := self.iter.Next()
+		if value.IsNone() {
+			return vec
+		}
+		vec.Push(value.Unwrap())
+	}
+}
+
+// collect to LinkedList
+func (self _IterBase[T]) CollectToLinkedList() LinkedList[T] {
+	list := LinkedListNew[T]()
+	for {
+		value := self.iter.Next()
+		if value.IsNone() {
+			return list
+		}
+		list.PushBack(value.Unwrap())
+	}
+}
+
+// into_iter
+// An Iterator is its own IntoIterator, so it can be passed anywhere an IntoIterator is accepted.
+func (self _IterBase[T]) IntoIter() Iterator[T] {
+	return self.iter
+}
+
+// Consumes the iterator, counting the number of iterations and returning it.
+//
+//	vec := gost.VecNew[gost.I32]()
+//	vec.Push(1)
+//	vec.Push(2)
+//	gost.AssertEq(vec.IntoIter().Count(), gost.USize(2))
+func (self _IterBase[T]) Count() USize {
+	count := USize(0)
+
+	for self.iter.Next().IsSome() {
+		count++
+	}
+
+	return count
+}
+
+// Consumes the iterator, returning the last element.
+//
+//	vec := gost.VecNew[gost.I32]()
+//	vec.Push(1)
+//	vec.Push(2)
+//	gost.AssertEq(vec.IntoIter().Last(), gost.Some[gost.I32](2))
+func (self _IterBase[T]) Last() Option[T] {
+	last := None[T]()
+
+	for {
+		value := self.iter.Next()
+
+		if value.IsNone() {
+			return last
+		}
+		last = value
+	}
+}
+
+// Returns the nth element of the iterator.
+// Like most indexing operations, the count starts from zero, so Nth(0) returns the first value.
+// All preceding elements, as well as the returned element, will be consumed from the iterator.
+//
+//	vec := gost.VecNew[gost.I32]()
+//	vec.Push(1)
+//	vec.Push(2)
+//	gost.AssertEq(vec.IntoIter().Nth(1), gost.Some[gost.I32](2))
+func (self _IterBase[T]) Nth(n USize) Option[T] {
+	for i := USize(0); i < n; i++ {
+		if self.iter.Next().IsNone() {
+			return None[T]()
+		}
+	}
+
+	return self.iter.Next()
+}
+
+// Tests if any element of the iterator matches a predicate.
+// It stops processing as soon as it finds a true.
+//
+//	vec := gost.VecNew[gost.I32]()
+//	vec.Push(1)
+//	vec.Push(2)
+//	gost.Assert(vec.IntoIter().Any(func(e gost.I32) gost.Bool { return e == 2 }))
+func (self _IterBase[T]) Any(f func(T) Bool) Bool {
+	for {
+		value := self.iter.Next()
+
+		if value.IsNone() {
+			return false
+		}
+
+		if f(value.Unwrap()) {
+			return true
+		}
+	}
+}
+
+// Tests if every element of the iterator matches a predicate.
+// It stops processing as soon as it finds a false. An empty iterator returns true.
+//
+//	vec := gost.VecNew[gost.I32]()
+//	vec.Push(1)
+//	vec.Push(2)
+//	gost.Assert(vec.IntoIter().All(func(e gost.I32) gost.Bool { return e > 0 }))
+func (self _IterBase[T]) All(f func(T) Bool) Bool {
+	for {
+		value := self.iter.Next()
+
+		if value.IsNone() {
+			return true
+		}
+
+		if !f(value.Unwrap()) {
+			return false
+		}
+	}
+}
+
+// Searches for an element of an iterator that satisfies a predicate.
+//
+//	vec := gost.VecNew[gost.I32]()
+//	vec.Push(1)
+//	vec.Push(2)
+//	gost.AssertEq(vec.IntoIter().Find(func(e gost.I32) gost.Bool { return e > 1 }), gost.Some[gost.I32](2))
+func (self _IterBase[T]) Find(predicate func(T) Bool) Option[T] {
+	for {
+		value := self.iter.Next()
+
+		if value.IsNone() || predicate(value.Unwrap()) {
+			return value
+		}
+	}
+}
+
+// Searches for an element in an iterator, returning its index.
+//
+//	vec := gost.VecNew[gost.I32]()
+//	vec.Push(1)
+//	vec.Push(2)
+//	gost.AssertEq(vec.IntoIter().Position(func(e gost.I32) gost.Bool { return e == 2 }), gost.Some[gost.USize](1))
+func (self _IterBase[T]) Position(predicate func(T) Bool) Option[USize] {
+	index := USize(0)
+
+	for {
+		value := self.iter.Next()
+
+		if value.IsNone() {
+			return None[USize]()
+		}
+
+		if predicate(value.Unwrap()) {
+			return Some[USize](index)
+		}
+		index++
+	}
+}
+
+// Require `impl Ord[T] for T`
+// Returns the minimum element of an iterator.
+// If several elements are equally minimum, the first element is returned. If the iterator is empty, None is returned.
+//
+//	vec := gost.VecNew[gost.I32]()
+//	vec.Push(2)
+//	vec.Push(1)
+//	gost.AssertEq(vec.IntoIter().Min(), gost.Some[gost.I32](1))
+func (self _IterBase[T]) Min() Option[T] {
+	return self.MinBy(_CompareOrd[T])
+}
+
+// Require `impl Ord[T] for T`
+// Returns the maximum element of an iterator.
+// If several elements are equally maximum, the last element is returned. If the iterator is empty, None is returned.
+//
+//	vec := gost.VecNew[gost.I32]()
+//	vec.Push(2)
+//	vec.Push(1)
+//	gost.AssertEq(vec.IntoIter().Max(), gost.Some[gost.I32](2))
+func (self _IterBase[T]) Max() Option[T] {
+	return self.MaxBy(_CompareOrd[T])
+}
+
+// Returns the element that gives the minimum value with respect to the specified comparison function.
+// If several elements are equally minimum, the first element is returned. If the iterator is empty, None is returned.
+func (self _IterBase[T]) MinBy(compare func(T, T) Ordering) Option[T] {
+	min := self.iter.Next()
+
+	if min.IsNone() {
+		return min
+	}
+
+	for {
+		value := self.iter.Next()
+
+		if value.IsNone() {
+			return min
+		}
+
+		if compare(value.Unwrap(), min.Unwrap()) == OrderingLess {
+			min = value
+		}
+	}
+}
+
+// Returns the element that gives the maximum value with respect to the specified comparison function.
+// If several elements are equally maximum, the last element is returned. If the iterator is empty, None is returned.
+func (self _IterBase[T]) MaxBy(compare func(T, T) Ordering) Option[T] {
+	max := self.iter.Next()
+
+	if max.IsNone() {
+		return max
+	}
+
+	for {
+		value := self.iter.Next()
+
+		if value.IsNone() {
+			return max
+		}
+
+		if compare(value.Unwrap(), max.Unwrap()) != OrderingLess {
+			max = value
+		}
+	}
+}
+
+// Require `impl Add[T] for T`
+// Sums the elements of an iterator.
+// An empty iterator returns the zero value of the type.
+//
+//	vec := gost.VecNew[gost.I32]()
+//	vec.Push(1)
+//	vec.Push(2)
+//	gost.AssertEq(vec.IntoIter().Sum(), gost.I32(3))
+func (self _IterBase[T]) Sum() T {
+	var sum T
+
+	for {
+		value := self.iter.Next()
+
+		if value.IsNone() {
+			return sum
+		}
+
+		add := castToAdd(sum)
+		if add.IsNone() {
+			typeName := getTypeName(sum)
+			panic(fmt.Sprintf("'%s' does not implement Add[%s]", typeName, typeName))
+		}
+		sum = add.Unwrap().Add(value.Unwrap())
+	}
+}
+
+// Require `impl Mul[T] for T`
+// Iterates over the entire iterator, multiplying all the elements.
+// An empty iterator returns the one value of the type.
+//
+//	vec := gost.VecNew[gost.I32]()
+//	vec.Push(2)
+//	vec.Push(3)
+//	gost.AssertEq(vec.IntoIter().Product(), gost.I32(6))
+func (self _IterBase[T]) Product() T {
+	product := self.iter.Next()
+
+	if product.IsNone() {
+		return _One[T]()
+	}
+
+	result := product.Unwrap()
+
+	for {
+		value := self.iter.Next()
+
+		if value.IsNone() {
+			return result
+		}
+
+		mul := castToMul(result)
+		if mul.IsNone() {
+			typeName := getTypeName(result)
+			panic(fmt.Sprintf("'%s' does not implement Mul[%s]", typeName, typeName))
+		}
+		result = mul.Unwrap().Mul(value.Unwrap())
+	}
+}
+
+// Creates an iterator that yields the first n elements, or fewer if the underlying iterator ends sooner.
+//
+//	vec := gost.VecNew[gost.I32]()
+//	vec.Push(1)
+//	vec.Push(2)
+//	vec.Push(3)
+//	gost.AssertEq(vec.IntoIter().Take(2).Count(), gost.USize(2))
+func (self _IterBase[T]) Take(n USize) Iterator[T] {
+	iter := &TakeIter[T]{iter: self.iter, n: n}
+	iter._IterBase = _IterBase[T]{iter}
+	return iter
+}
+
+// Creates an iterator that skips the first n elements.
+//
+//	vec := gost.VecNew[gost.I32]()
+//	vec.Push(1)
+//	vec.Push(2)
+//	vec.Push(3)
+//	gost.AssertEq(vec.IntoIter().Skip(2).Next(), gost.Some[gost.I32](3))
+func (self _IterBase[T]) Skip(n USize) Iterator[T] {
+	iter := &SkipIter[T]{iter: self.iter, n: n}
+	iter._IterBase = _IterBase[T]{iter}
+	return iter
+}
+
+// Creates an iterator that yields elements based on a predicate.
+// It yields elements until the predicate returns false; the rest of the elements are ignored.
+//
+//	vec := gost.VecNew[gost.I32]()
+//	vec.Push(1)
+//	vec.Push(5)
+//	vec.Push(2)
+//	gost.AssertEq(vec.IntoIter().TakeWhile(func(e gost.I32) gost.Bool { return e < 3 }).Count(), gost.USize(1))
+func (self _IterBase[T]) TakeWhile(predicate func(T) Bool) Iterator[T] {
+	iter := &TakeWhileIter[T]{iter: self.iter, predicate: predicate, done: false}
+	iter._IterBase = _IterBase[T]{iter}
+	return iter
+}
+
+// Creates an iterator that skips elements based on a predicate.
+// After the predicate returns false for the first time, the rest of the elements are yielded.
+//
+//	vec := gost.VecNew[gost.I32]()
+//	vec.Push(1)
+//	vec.Push(5)
+//	vec.Push(2)
+//	gost.AssertEq(vec.IntoIter().SkipWhile(func(e gost.I32) gost.Bool { return e < 3 }).Count(), gost.USize(2))
+func (self _IterBase[T]) SkipWhile(predicate func(T) Bool) Iterator[T] {
+	iter := &SkipWhileIter[T]{iter: self.iter, predicate: predicate, started: false}
+	iter._IterBase = _IterBase[T]{iter}
+	return iter
+}
+
+// Creates an iterator starting at the same point, but stepping by the given amount at each iteration.
+// The first element of the iterator will always be returned, regardless of the step given.
+// Panics if step is 0.
+//
+//	vec := gost.VecNew[gost.I32]()
+//	vec.Push(0)
+//	vec.Push(1)
+//	vec.Push(2)
+//	gost.AssertEq(vec.IntoIter().StepBy(2).Last(), gost.Some[gost.I32](2))
+func (self _IterBase[T]) StepBy(step USize) Iterator[T] {
+	if step == 0 {
+		panic("StepBy: step must be greater than 0")
+	}
+
+	iter := &StepByIter[T]{iter: self.iter, step: step, first: true}
+	iter._IterBase = _IterBase[T]{iter}
+	return iter
+}
+
+// Takes two iterators and creates a new iterator over both in sequence.
+//
+//	a := gost.VecNew[gost.I32]()
+//	a.Push(1)
+//	b := gost.VecNew[gost.I32]()
+//	b.Push(2)
+//	gost.AssertEq(a.IntoIter().Chain(b.IntoIter()).Count(), gost.USize(2))
+func (self _IterBase[T]) Chain(other Iterator[T]) Iterator[T] {
+	iter := &ChainIter[T]{a: self.iter, b: other, aDone: false}
+	iter._IterBase = _IterBase[T]{iter}
+	return iter
+}
+
+// Creates an iterator which can use the Peek method to look at the next element of the iterator without consuming it.
+//
+//	vec := gost.VecNew[gost.I32]()
+//	vec.Push(1)
+//	iter := vec.IntoIter().Peekable()
+//	gost.AssertEq(iter.Peek(), gost.Some[gost.I32](1))
+//	gost.AssertEq(iter.Next(), gost.Some[gost.I32](1))
+func (self _IterBase[T]) Peekable() *PeekableIter[T] {
+	iter := &PeekableIter[T]{iter: self.iter, peeked: None[Option[T]]()}
+	iter._IterBase = _IterBase[T]{iter}
+	return iter
+}
+
+// Repeats an iterator endlessly.
+// Elements are buffered on the first pass and replayed afterwards. An empty iterator stays empty.
+//
+//	vec := gost.VecNew[gost.I32]()
+//	vec.Push(1)
+//	vec.Push(2)
+//	gost.AssertEq(vec.IntoIter().Cycle().Nth(2), gost.Some[gost.I32](1))
+func (self _IterBase[T]) Cycle() Iterator[T] {
+	iter := &CycleIter[T]{iter: self.iter, buffer: VecNew[T](), sourceDone: false, position: 0}
+	iter._IterBase = _IterBase[T]{iter}
+	return iter
+}
+
+func _CompareOrd[T any](lhs T, rhs T) Ordering {
+	ord := castToOrd(lhs)
+
+	if ord.IsNone() {
+		typeName := getTypeName(lhs)
+		panic(fmt.Sprintf("'%s' does not implement Ord[%s]", typeName, typeName))
+	}
+
+	return ord.Unwrap().Cmp(rhs)
+}
+
+// Returns the multiplicative identity of T, used as the product of an empty iterator.
+func _One[T any]() T {
+	var one T
+
+	switch value := any(&one).(type) {
+	case *I128:
+		*value = I128_FromI64(1)
+		return one
+	case *U128:
+		*value = U128_FromU64(1)
+		return one
+	}
+
+	reflectedValue := reflect.ValueOf(&one).Elem()
+
+	switch reflectedValue.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		reflectedValue.SetInt(1)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		reflectedValue.SetUint(1)
+	case reflect.Float32, reflect.Float64:
+		reflectedValue.SetFloat(1)
+	case reflect.Complex64, reflect.Complex128:
+		reflectedValue.SetComplex(1)
+	default:
+		typeName := getTypeName(one)
+		panic(fmt.Sprintf("'%s' has no multiplicative identity", typeName))
+	}
+
+	return one
+}
+
+// An iterator that maps the values of iter with f.
+// This is created by Iterator.Map and MapTo.
+type MapIter[T any, U any] struct {
+	_IterBase[U]
+	iter Iterator[T]
+	f    func(T) U
+}
+
+// Takes a closure and creates an iterator which calls that closure on each element.
+// Unlike Iterator.Map, the closure may change the element type.
+// The closure is called lazily, as elements are pulled from the returned iterator.
+//
+//	vec := gost.VecNew[gost.I32]()
+//	vec.Push(1)
+//	vec.Push(2)
+//	strings := gost.MapTo(vec.IntoIter(), func(e gost.I32) gost.String { return e.ToString() }).CollectToVec()
+//	gost.AssertEq(strings.GetUnchecked(0), gost.String("1"))
+func MapTo[T any, U any](iter Iterator[T], f func(T) U) Iterator[U] {
+	mapIter := &MapIter[T, U]{iter: iter, f: f}
+	mapIter._IterBase = _IterBase[U]{mapIter}
+	return mapIter
+}
+
+// next
+func (self *MapIter[T, U]) Next() Option[U] {
+	value := self.iter.Next()
+
+	if value.IsNone() {
+		return None[U]()
+	}
+
+	return Some[U](self.f(value.Unwrap()))
+}
+
+// rev
+func (self *MapIter[T, U]) Rev() Iterator[U] {
+	return MapTo(self.iter.Rev(), self.f)
+}
+
+// An iterator that filters the elements of iter with predicate.
+// This is created by Iterator.Filter.
+type FilterIter[T any] struct {
+	_IterBase[T]
+	iter Iterator[T]
+	f    func(T) Bool
+}
+
+// next
+func (self *FilterIter[T]) Next() Option[T] {
+	for {
+		value := self.iter.Next()
+
+		if value.IsNone() || self.f(value.Unwrap()) {
+			return value
+		}
+	}
+}
+
+// rev
+func (self *FilterIter[T]) Rev() Iterator[T] {
+	return self.iter.Rev().Filter(self.f)
+}
+
+// An iterator that uses f to both filter and map elements from iter.
+// This is created by FilterMap.
+type FilterMapIter[T any, U any] struct {
+	_IterBase[U]
+	iter Iterator[T]
+	f    func(T) Option[U]
+}
+
+// Creates an iterator that both filters and maps.
+// The returned iterator yields only the values for which the supplied closure returns Some(value).
+//
+//	vec := gost.VecNew[gost.String]()
+//	vec.Push("1")
+//	vec.Push("two")
+//	vec.Push("3")
+//	numbers := gost.FilterMap(vec.IntoIter(), func(e gost.String) gost.Option[gost.I64] { return e.ParseI64().Ok() }).CollectToVec()
+//	gost.AssertEq(numbers.Len(), gost.USize(2))
+func FilterMap[T any, U any](iter Iterator[T], f func(T) Option[U]) Iterator[U] {
+	filterMapIter := &FilterMapIter[T, U]{iter: iter, f: f}
+	filterMapIter._IterBase = _IterBase[U]{filterMapIter}
+	return filterMapIter
+}
+
+// next
+func (self *FilterMapIter[T, U]) Next() Option[U] {
+	for {
+		value := self.iter.Next()
+
+		if value.IsNone() {
+			return None[U]()
+		}
+
+		mapped := self.f(value.Unwrap())
+		if mapped.IsSome() {
+			return mapped
+		}
+	}
+}
+
+// rev
+func (self *FilterMapIter[T, U]) Rev() Iterator[U] {
+	return FilterMap(self.iter.Rev(), self.f)
+}
+
+// An iterator that maps each element to an iterator, and yields the elements of the produced iterators.
+// This is created by FlatMap.
+type FlatMapIter[T any, U any] struct {
+	_IterBase[U]
+	iter    Iterator[T]
+	f       func(T) Iterator[U]
+	current Option[Iterator[U]]
+}
+
+// Creates an iterator that works like map, but flattens nested structure.
+// The closure returns an iterator for each element, and the elements of those iterators are yielded in order.
+//
+//	vec := gost.VecNew[gost.String]()
+//	vec.Push("ab")
+//	vec.Push("cd")
+//	chars := gost.FlatMap(vec.IntoIter(), func(e gost.String) gost.Iterator[gost.Char] { return e.Chars().IntoIter() }).CollectToVec()
+//	gost.AssertEq(chars.Len(), gost.USize(4))
+func FlatMap[T any, U any](iter Iterator[T], f func(T) Iterator[U]) Iterator[U] {
+	flatMapIter := &FlatMapIter[T, U]{iter: iter, f: f, current: None[Iterator[U]]()}
+	flatMapIter._IterBase = _IterBase[U]{flatMapIter}
+	return flatMapIter
+}
+
+// next
+func (self *FlatMapIter[T, U]) Next() Option[U] {
+	for {
+		if self.current.IsSome() {
+			value := self.current.Unwrap().Next()
+
+			if value.IsSome() {
+				return value
+			}
+			self.current = None[Iterator[U]]()
+		}
+
+		outer := self.iter.Next()
+
+		if outer.IsNone() {
+			return None[U]()
+		}
+		self.current = Some(self.f(outer.Unwrap()))
+	}
+}
+
+// An iterator that iterates two other iterators simultaneously.
+// This is created by Zip.
+type ZipIter[T any, U any] struct {
+	_IterBase[Pair[T, U]]
+	a Iterator[T]
+	b Iterator[U]
+}
+
+// ‘Zips up’ two iterators into a single iterator of pairs.
+// The returned iterator ends as soon as either of the two iterators ends.
+//
+//	a := gost.VecNew[gost.I32]()
+//	a.Push(1)
+//	a.Push(2)
+//	b := gost.VecNew[gost.String]()
+//	b.Push("one")
+//	b.Push("two")
+//	zipped := gost.Zip(a.IntoIter(), b.IntoIter()).CollectToVec()
+//	gost.AssertEq(zipped.GetUnchecked(1).Value, gost.String("two"))
+func Zip[T any, U any](a Iterator[T], b Iterator[U]) Iterator[Pair[T, U]] {
+	zipIter := &ZipIter[T, U]{a: a, b: b}
+	zipIter._IterBase = _IterBase[Pair[T, U]]{zipIter}
+	return zipIter
+}
+
+// next
+func (self *ZipIter[T, U]) Next() Option[Pair[T, U]] {
+	left := self.a.Next()
+
+	if left.IsNone() {
+		return None[Pair[T, U]]()
+	}
+
+	right := self.b.Next()
+
+	if right.IsNone() {
+		return None[Pair[T, U]]()
+	}
+
+	return Some(Pair[T, U]{Key: left.Unwrap(), Value: right.Unwrap()})
+}
+
+// An iterator that yields the current count and the element during iteration.
+// This is created by Enumerate.
+type EnumerateIter[T any] struct {
+	_IterBase[Pair[USize, T]]
+	iter  Iterator[T]
+	count USize
+}
+
+// Creates an iterator which gives the current iteration count as well as the next value.
+// The returned iterator yields pairs (i, val), where i is the current index of iteration and val is the value returned by the iterator.
+//
+//	vec := gost.VecNew[gost.String]()
+//	vec.Push("a")
+//	vec.Push("b")
+//	enumerated := gost.Enumerate(vec.IntoIter()).CollectToVec()
+//	gost.AssertEq(enumerated.GetUnchecked(1).Key, gost.USize(1))
+func Enumerate[T any](iter Iterator[T]) Iterator[Pair[USize, T]] {
+	enumerateIter := &EnumerateIter[T]{iter: iter, count: 0}
+	enumerateIter._IterBase = _IterBase[Pair[USize, T]]{enumerateIter}
+	return enumerateIter
+}
+
+// next
+func (self *EnumerateIter[T]) Next() Option[Pair[USize, T]] {
+	value := self.iter.Next()
+
+	if value.IsNone() {
+		return None[Pair[USize, T]]()
+	}
+
+	pair := Pair[USize, T]{Key: self.count, Value: value.Unwrap()}
+	self.count++
+
+	return Some(pair)
+}
+
+// A double-ended iterator with the direction inverted.
+// This is created by Iterator.Rev.
+type RevIter[T any] struct {
+	_IterBase[T]
+	iter DoubleEndedIterator[T]
+}
+
+// next
+func (self *RevIter[T]) Next() Option[T] {
+	return self.iter.NextBack()
+}
+
+// next_back
+func (self *RevIter[T]) NextBack() Option[T] {
+	return self.iter.Next()
+}
+
+// rev
+func (self *RevIter[T]) Rev() Iterator[T] {
+	return self.iter
+}
+
+// An iterator that only iterates over the first n elements of iter.
+// This is created by Iterator.Take.
+type TakeIter[T any] struct {
+	_IterBase[T]
+	iter Iterator[T]
+	n    USize
+}
+
+// next
+func (self *TakeIter[T]) Next() Option[T] {
+	if self.n == 0 {
+		return None[T]()
+	}
+
+	self.n--
+
+	return self.iter.Next()
+}
+
+// An iterator that skips over n elements of iter.
+// This is created by Iterator.Skip.
+type SkipIter[T any] struct {
+	_IterBase[T]
+	iter Iterator[T]
+	n    USize
+}
+
+// next
+func (self *SkipIter[T]) Next() Option[T] {
+	if self.n > 0 {
+		n := self.n
+		self.n = 0
+
+		return self.iter.Nth(n)
+	}
+
+	return self.iter.Next()
+}
+
+// An iterator that only accepts elements while predicate returns true.
+// This is created by Iterator.TakeWhile.
+type TakeWhileIter[T any] struct {
+	_IterBase[T]
+	iter      Iterator[T]
+	predicate func(T) Bool
+	done      bool
+}
+
+// next
+func (self *TakeWhileIter[T]) Next() Option[T] {
+	if self.done {
+		return None[T]()
+	}
+
+	value := self.iter.Next()
+
+	if value.IsNone() || !self.predicate(value.Unwrap()) {
+		self.done = true
+		return None[T]()
+	}
+
+	return value
+}
+
+// An iterator that rejects elements while predicate returns true.
+// This is created by Iterator.SkipWhile.
+type SkipWhileIter[T any] struct {
+	_IterBase[T]
+	iter      Iterator[T]
+	predicate func(T) Bool
+	started   bool
+}
+
+// next
+func (self *SkipWhileIter[T]) Next() Option[T] {
+	if self.started {
+		return self.iter.Next()
+	}
+
+	for {
+		value := self.iter.Next()
+
+		if value.IsNone() || !self.predicate(value.Unwrap()) {
+			self.started = true
+			return value
+		}
+	}
+}
+
+// An iterator for stepping iterators by a custom amount.
+// This is created by Iterator.StepBy.
+type StepByIter[T any] struct {
+	_IterBase[T]
+	iter  Iterator[T]
+	step  USize
+	first bool
+}
+
+// next
+func (self *StepByIter[T]) Next() Option[T] {
+	if self.first {
+		self.first = false
+		return self.iter.Next()
+	}
+
+	return self.iter.Nth(self.step - 1)
+}
+
+// An iterator that links two iterators together, in a chain.
+// This is created by Iterator.Chain.
+type ChainIter[T any] struct {
+	_IterBase[T]
+	a     Iterator[T]
+	b     Iterator[T]
+	aDone bool
+}
+
+// next
+func (self *ChainIter[T]) Next() Option[T] {
+	if !self.aDone {
+		value := self.a.Next()
+
+		if value.IsSome() {
+			return value
+		}
+		self.aDone = true
+	}
+
+	return self.b.Next()
+}
+
+// rev
+func (self *ChainIter[T]) Rev() Iterator[T] {
+	if self.aDone {
+		return self.b.Rev()
+	}
+
+	return self.b.Rev().Chain(self.a.Rev())
+}
+
+// An iterator with a Peek method that returns an optional reference to the next element.
+// This is created by Iterator.Peekable.
+type PeekableIter[T any] struct {
+	_IterBase[T]
+	iter   Iterator[T]
+	peeked Option[Option[T]]
+}
+
+// next
+func (self *PeekableIter[T]) Next() Option[T] {
+	if self.peeked.IsSome() {
+		return self.peeked.Take().Unwrap()
+	}
+
+	return self.iter.Next()
+}
+
+// Returns the next value without advancing the iterator.
+//
+//	vec := gost.VecNew[gost.I32]()
+//	vec.Push(1)
+//	iter := vec.IntoIter().Peekable()
+//	gost.AssertEq(iter.Peek(), gost.Some[gost.I32](1))
+//	gost.AssertEq(iter.Peek(), gost.Some[gost.I32](1))
+func (self *PeekableIter[T]) Peek() Option[T] {
+	if self.peeked.IsNone() {
+		self.peeked = Some(self.iter.Next())
+	}
+
+	return self.peeked.Unwrap()
+}
+
+// Consume and return the next value of this iterator if a condition is true.
+// If f returns true for the next value of this iterator, consume and return it. Otherwise, return None.
+//
+//	vec := gost.VecNew[gost.I32]()
+//	vec.Push(1)
+//	vec.Push(2)
+//	iter := vec.IntoIter().Peekable()
+//	gost.AssertEq(iter.NextIf(func(e gost.I32) gost.Bool { return e == 1 }), gost.Some[gost.I32](1))
+//	gost.AssertEq(iter.NextIf(func(e gost.I32) gost.Bool { return e == 1 }), gost.None[gost.I32]())
+func (self *PeekableIter[T]) NextIf(f func(T) Bool) Option[T] {
+	next := self.Peek()
+
+	if next.IsSome() && f(next.Unwrap()) {
+		return self.Next()
+	}
+
+	return None[T]()
+}
+
+// An iterator that repeats endlessly.
+// This is created by Iterator.Cycle.
+type CycleIter[T any] struct {
+	_IterBase[T]
+	iter       Iterator[T]
+	buffer     Vec[T]
+	sourceDone bool
+	position   USize
+}
+
+// next
+func (self *CycleIter[T]) Next() Option[T] {
+	if !self.sourceDone {
+		value := self.iter.Next()
+
+		if value.IsSome() {
+			self.buffer.Push(value.Unwrap())
+			return value
+		}
+		self.sourceDone = true
+	}
+
+	if self.buffer.IsEmpty() {
+		return None[T]()
+	}
+
+	value := self.buffer.GetUnchecked(self.position)
+	self.position = (self.position + 1) % self.buffer.Len()
+
+	return Some[T](value)
+}
+
+// rev
+// Panics, because a cycle has no last element to start from.
+func (self *CycleIter[T]) Rev() Iterator[T] {
+	panic("Rev called on a CycleIter, which never ends")
+}
+
+// Folds every element into an accumulator by applying an operation, returning the final result.
+// Unlike Iterator.Fold, the accumulator may have a different type than the elements.
+//
+//	vec := gost.VecNew[gost.I32]()
+//	vec.Push(1)
+//	vec.Push(2)
+//	joined := gost.FoldTo(vec.IntoIter(), gost.String(""), func(acc gost.String, e gost.I32) gost.String { return acc + e.ToString() })
+//	gost.AssertEq(joined, gost.String("12"))
+func FoldTo[T any, Acc any](iter Iterator[T], init Acc, f func(Acc, T) Acc) Acc {
+	for {
+		value := iter.Next()
+
+		if value.IsNone() {
+			return init
+		}
+
+		init = f(init, value.Unwrap())
+	}
+}
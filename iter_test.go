@@ -0,0 +1,348 @@
+package gost
+
+import "testing"
+
+func Test_MapTo(t *testing.T) {
+	t.Parallel()
+
+	vec := VecNew[I32]()
+	vec.Push(1)
+	vec.Push(2)
+	vec.Push(3)
+
+	strings := MapTo(vec.IntoIter(), func(e I32) String { return e.ToString() }).CollectToVec()
+
+	AssertEq(strings.Len(), USize(3), "MapTo length")
+	AssertEq(strings.GetUnchecked(0), String("1"), "MapTo first")
+	AssertEq(strings.GetUnchecked(2), String("3"), "MapTo last")
+}
+
+func Test_FilterMap(t *testing.T) {
+	t.Parallel()
+
+	vec := VecNew[String]()
+	vec.Push("1")
+	vec.Push("two")
+	vec.Push("3")
+
+	numbers := FilterMap(vec.IntoIter(), func(e String) Option[I64] { return e.ParseI64().Ok() }).CollectToVec()
+
+	AssertEq(numbers.Len(), USize(2), "FilterMap length")
+	AssertEq(numbers.GetUnchecked(0), I64(1), "FilterMap first")
+	AssertEq(numbers.GetUnchecked(1), I64(3), "FilterMap second")
+}
+
+func Test_FlatMap(t *testing.T) {
+	t.Parallel()
+
+	vec := VecNew[I32]()
+	vec.Push(1)
+	vec.Push(2)
+
+	flattened := FlatMap(vec.IntoIter(), func(e I32) Iterator[I32] {
+		inner := VecNew[I32]()
+		inner.Push(e)
+		inner.Push(e * 10)
+		return inner.IntoIter()
+	}).CollectToVec()
+
+	AssertEq(flattened.Len(), USize(4), "FlatMap length")
+	AssertEq(flattened.GetUnchecked(1), I32(10), "FlatMap inner order")
+	AssertEq(flattened.GetUnchecked(2), I32(2), "FlatMap outer order")
+}
+
+func Test_Zip(t *testing.T) {
+	t.Parallel()
+
+	a := VecNew[I32]()
+	a.Push(1)
+	a.Push(2)
+	a.Push(3)
+
+	b := VecNew[String]()
+	b.Push("one")
+	b.Push("two")
+
+	zipped := Zip(a.IntoIter(), b.IntoIter()).CollectToVec()
+
+	AssertEq(zipped.Len(), USize(2), "Zip stops at the shorter iterator")
+	AssertEq(zipped.GetUnchecked(1).Key, I32(2), "Zip key")
+	AssertEq(zipped.GetUnchecked(1).Value, String("two"), "Zip value")
+}
+
+func Test_Enumerate(t *testing.T) {
+	t.Parallel()
+
+	set := BTreeSetNew[String]()
+	set.Insert("a")
+	set.Insert("b")
+
+	enumerated := Enumerate(set.IntoIter()).CollectToVec()
+
+	AssertEq(enumerated.GetUnchecked(0).Key, USize(0), "Enumerate first index")
+	AssertEq(enumerated.GetUnchecked(1).Key, USize(1), "Enumerate second index")
+	AssertEq(enumerated.GetUnchecked(1).Value, String("b"), "Enumerate value")
+}
+
+func Test_FoldTo(t *testing.T) {
+	t.Parallel()
+
+	hashMap := HashMapNew[String, I32]()
+	hashMap.Insert("a", 1)
+	hashMap.Insert("b", 2)
+
+	sum := FoldTo(hashMap.IntoIter(), I64(0), func(acc I64, e Pair[String, I32]) I64 { return acc + I64(e.Value) })
+
+	AssertEq(sum, I64(3), "FoldTo")
+}
+
+func Test_Iterator_Lazy(t *testing.T) {
+	t.Parallel()
+
+	vec := VecNew[I32]()
+	for i := I32(0); i < 100; i++ {
+		vec.Push(i)
+	}
+
+	calls := ISize(0)
+	iter := vec.IntoIter().Map(func(e I32) I32 {
+		calls++
+		return e * 2
+	}).Filter(func(e I32) Bool { return e%4 == 0 })
+
+	AssertEq(calls, 0, "Map must not run before Next")
+	AssertEq(iter.Next(), Some[I32](0), "first element")
+	AssertEq(iter.Next(), Some[I32](4), "second element")
+	AssertEq(calls, 3, "Map runs only for pulled elements")
+}
+
+func Test_Iterator_Rev(t *testing.T) {
+	t.Parallel()
+
+	vec := VecNew[I32]()
+	vec.Push(1)
+	vec.Push(2)
+	vec.Push(3)
+
+	reversed := vec.IntoIter().Rev().CollectToVec()
+	AssertEq(reversed.GetUnchecked(0), I32(3), "Vec Rev")
+
+	mapped := vec.IntoIter().Map(func(e I32) I32 { return e * 10 }).Rev().CollectToVec()
+	AssertEq(mapped.GetUnchecked(0), I32(30), "Map Rev")
+
+	enumerated := Enumerate(vec.IntoIter()).Rev().CollectToVec()
+	AssertEq(enumerated.GetUnchecked(0).Key, USize(2), "Enumerate Rev")
+
+	list := LinkedListNew[I32]()
+	list.PushBack(1)
+	list.PushBack(2)
+	list.PushBack(3)
+
+	iter := list.IntoIter().(DoubleEndedIterator[I32])
+	AssertEq(iter.Next(), Some[I32](1), "LinkedList Next")
+	AssertEq(iter.NextBack(), Some[I32](3), "LinkedList NextBack")
+	AssertEq(iter.Next(), Some[I32](2), "LinkedList Next meets NextBack")
+	Assert(iter.NextBack().IsNone(), "LinkedList exhausted")
+
+	deque := VecDequeNew[I32]()
+	deque.PushBack(1)
+	deque.PushBack(2)
+	AssertEq(deque.IntoIter().Rev().CollectToVec().GetUnchecked(0), I32(2), "VecDeque Rev")
+}
+
+func Test_Iterator_Consumers(t *testing.T) {
+	t.Parallel()
+
+	vec := VecNew[I32]()
+	vec.Push(3)
+	vec.Push(1)
+	vec.Push(4)
+	vec.Push(1)
+
+	AssertEq(vec.IntoIter().Count(), USize(4), "Count")
+	AssertEq(vec.IntoIter().Last(), Some[I32](1), "Last")
+	AssertEq(vec.IntoIter().Nth(2), Some[I32](4), "Nth")
+	Assert(vec.IntoIter().Nth(4).IsNone(), "Nth out of range")
+	Assert(vec.IntoIter().Any(func(e I32) Bool { return e == 4 }), "Any")
+	Assert(!vec.IntoIter().All(func(e I32) Bool { return e > 1 }), "All")
+	AssertEq(vec.IntoIter().Find(func(e I32) Bool { return e > 3 }), Some[I32](4), "Find")
+	AssertEq(vec.IntoIter().Position(func(e I32) Bool { return e == 1 }), Some[USize](1), "Position")
+	AssertEq(vec.IntoIter().Min(), Some[I32](1), "Min")
+	AssertEq(vec.IntoIter().Max(), Some[I32](4), "Max")
+	AssertEq(vec.IntoIter().Sum(), I32(9), "Sum")
+	AssertEq(vec.IntoIter().Product(), I32(12), "Product")
+
+	pairs := Enumerate(vec.IntoIter()).CollectToVec()
+	byValue := func(a, b Pair[USize, I32]) Ordering { return a.Value.Cmp(b.Value) }
+	AssertEq(pairs.IntoIter().MinBy(byValue).Unwrap().Key, USize(1), "MinBy keeps the first minimum")
+	AssertEq(pairs.IntoIter().MaxBy(byValue).Unwrap().Key, USize(2), "MaxBy")
+
+	empty := VecNew[I32]()
+	Assert(empty.IntoIter().Min().IsNone(), "Min of empty")
+	AssertEq(empty.IntoIter().Sum(), I32(0), "Sum of empty")
+	AssertEq(empty.IntoIter().Product(), I32(1), "Product of empty")
+	AssertEq(VecNew[F64]().IntoIter().Product(), F64(1), "Product of empty floats")
+}
+
+func Test_Iterator_Adapters(t *testing.T) {
+	t.Parallel()
+
+	vec := VecNew[I32]()
+	for i := I32(0); i < 10; i++ {
+		vec.Push(i)
+	}
+
+	AssertEq(vec.IntoIter().Take(3).Last(), Some[I32](2), "Take")
+	AssertEq(vec.IntoIter().Skip(8).Next(), Some[I32](8), "Skip")
+	Assert(vec.IntoIter().Skip(10).Next().IsNone(), "Skip everything")
+	AssertEq(vec.IntoIter().TakeWhile(func(e I32) Bool { return e < 4 }).Count(), USize(4), "TakeWhile")
+	AssertEq(vec.IntoIter().SkipWhile(func(e I32) Bool { return e < 4 }).Next(), Some[I32](4), "SkipWhile")
+
+	stepped := vec.IntoIter().StepBy(3).CollectToVec()
+	AssertEq(stepped.Len(), USize(4), "StepBy length")
+	AssertEq(stepped.GetUnchecked(3), I32(9), "StepBy last")
+
+	chained := vec.IntoIter().Take(2).Chain(vec.IntoIter().Skip(8))
+	AssertEq(chained.CollectToVec().GetUnchecked(2), I32(8), "Chain")
+	AssertEq(vec.IntoIter().Take(2).Chain(vec.IntoIter().Skip(8)).Rev().Next(), Some[I32](9), "Chain Rev")
+
+	peekable := vec.IntoIter().Peekable()
+	AssertEq(peekable.Peek(), Some[I32](0), "Peek")
+	AssertEq(peekable.Peek(), Some[I32](0), "Peek does not advance")
+	AssertEq(peekable.NextIf(func(e I32) Bool { return e == 0 }), Some[I32](0), "NextIf match")
+	Assert(peekable.NextIf(func(e I32) Bool { return e == 0 }).IsNone(), "NextIf mismatch")
+	AssertEq(peekable.Next(), Some[I32](1), "Next after Peek")
+
+	AssertEq(vec.IntoIter().Take(3).Cycle().Nth(7), Some[I32](1), "Cycle")
+	Assert(VecNew[I32]().IntoIter().Cycle().Next().IsNone(), "Cycle of empty")
+	Assert(CatchUnwind(func() Unit { vec.IntoIter().Cycle().Rev(); return Unit{} }).IsErr(), "Rev of Cycle panics")
+	AssertEq(vec.IntoIter().Cycle().Take(3).Rev().Next(), Some[I32](2), "Rev of a bounded Cycle")
+}
+
+func Test_Iterator_StepBy_Zero(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		Assert(recover() != nil, "StepBy(0) must panic")
+	}()
+
+	VecNew[I32]().IntoIter().StepBy(0)
+}
+
+func Test_Collect(t *testing.T) {
+	t.Parallel()
+
+	vec := VecNew[I32]()
+	vec.Push(3)
+	vec.Push(1)
+	vec.Push(3)
+
+	AssertEq(Collect[HashSet[I32]](vec.IntoIter()).Len(), USize(2), "Collect HashSet")
+	treeSet := Collect[BTreeSet[I32]](vec.IntoIter())
+	AssertEq(treeSet.IntoIter().Next(), Some[I32](1), "Collect BTreeSet")
+	AssertEq(Collect[VecDeque[I32]](vec.IntoIter()).Back(), Some[I32](3), "Collect VecDeque")
+	heap := Collect[BinaryHeap[I32]](vec.IntoIter())
+	AssertEq(heap.Pop(), Some[I32](3), "Collect BinaryHeap")
+	AssertEq(Collect[Vec[I32]](vec.IntoIter().Rev()).GetUnchecked(1), I32(1), "Collect Vec")
+
+	chars := VecNew[Char]()
+	chars.Push('g')
+	chars.Push('o')
+	AssertEq(Collect[String](chars.IntoIter()), String("go"), "Collect String")
+
+	pairs := Enumerate(vec.IntoIter())
+	hashMap := Collect[HashMap[USize, I32]](pairs)
+	AssertEq(hashMap.Get(1), Some[I32](1), "Collect HashMap")
+	treeMap := Collect[BTreeMap[USize, I32]](Enumerate(vec.IntoIter()))
+	AssertEq(treeMap.Len(), USize(3), "Collect BTreeMap")
+}
+
+func Test_Extend(t *testing.T) {
+	t.Parallel()
+
+	vec := VecNew[I32]()
+	vec.Push(1)
+	vec.Push(2)
+
+	extended := VecNew[I32]()
+	extended.Extend(vec)
+	extended.Extend(vec.IntoIter().Map(func(e I32) I32 { return e * 10 }))
+	AssertEq(extended.Len(), USize(4), "Vec Extend")
+	AssertEq(extended.GetUnchecked(3), I32(20), "Vec Extend from an Iterator")
+
+	set := HashSetNew[I32]()
+	set.Extend(extended)
+	set.Extend(vec)
+	AssertEq(set.Len(), USize(4), "HashSet Extend")
+
+	list := LinkedListNew[I32]()
+	list.Extend(&set)
+	AssertEq(list.Len(), USize(4), "LinkedList Extend")
+
+	str := String("ab")
+	str.Extend(String("cd").Chars())
+	AssertEq(str, String("abcd"), "String Extend")
+}
+
+func _BenchmarkVec(len int) Vec[I64] {
+	vec := VecWithCapacity[I64](USize(len))
+	for i := 0; i < len; i++ {
+		vec.Push(I64(i))
+	}
+	return vec
+}
+
+func _LongChain(vec Vec[I64]) Iterator[I64] {
+	return vec.IntoIter().
+		Map(func(e I64) I64 { return e + 1 }).
+		Filter(func(e I64) Bool { return e%2 == 0 }).
+		Map(func(e I64) I64 { return e * 3 }).
+		Filter(func(e I64) Bool { return e%3 == 0 }).
+		Map(func(e I64) I64 { return e - 1 })
+}
+
+// Chaining five adapters and folding must not allocate intermediate collections.
+func Benchmark_Iterator_LongChain_Fold(b *testing.B) {
+	vec := _BenchmarkVec(1_000_000)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_LongChain(vec).Fold(0, func(acc, e I64) I64 { return acc + e })
+	}
+}
+
+// Taking a few elements from a long chain only touches the elements that are pulled.
+func Benchmark_Iterator_LongChain_First(b *testing.B) {
+	vec := _BenchmarkVec(1_000_000)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		iter := _LongChain(vec)
+		for j := 0; j < 10; j++ {
+			iter.Next()
+		}
+	}
+}
+
+// Baseline for the chains above: a plain loop over the same elements.
+func Benchmark_Iterator_Loop(b *testing.B) {
+	vec := _BenchmarkVec(1_000_000)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		acc := I64(0)
+		for _, e := range vec.AsSlice() {
+			e = e + 1
+			if e%2 == 0 && (e*3)%3 == 0 {
+				acc += e*3 - 1
+			}
+		}
+		_ = acc
+	}
+}
@@ -235,128 +235,74 @@ func (list *LinkedList[T]) Append(other *LinkedList[T]) {
 
 // into_iter
 func (list *LinkedList[T]) IntoIter() Iterator[T] {
-	return &LinkedListIter[T]{
-		pointer: list.head,
+	iter := &LinkedListIter[T]{
+		pointer:     list.head,
+		backPointer: list.tail,
+		len:         list.len,
 	}
+	iter._IterBase = _IterBase[T]{iter}
+	return iter
 }
 
 // linked list iterator
 type LinkedListIter[T any] struct {
-	pointer *LinkedListNode[T]
+	_IterBase[T]
+	pointer     *LinkedListNode[T]
+	backPointer *LinkedListNode[T]
+	len         USize
 }
 
 // next
 func (self *LinkedListIter[T]) Next() Option[T] {
-	if self.pointer == nil {
+	if self.len == 0 {
 		return None[T]()
 	}
 
 	value := self.pointer.value
 	self.pointer = self.pointer.next
+	self.len--
 
 	return Some[T](value)
 }
 
-// map
-func (self LinkedListIter[T]) Map(f func(T) T) Iterator[T] {
-	currentIter := self
-
-	newList := LinkedListNew[T]()
-
-	for {
-		value := currentIter.Next()
-
-		if value.IsNone() {
-			break
-		}
-
-		newList.PushBack(f(value.Unwrap()))
-	}
-
-	return newList.IntoIter()
-}
-
-// filter
-func (self LinkedListIter[T]) Filter(f func(T) Bool) Iterator[T] {
-	currentIter := self
-
-	newList := LinkedListNew[T]()
-
-	for {
-		value := currentIter.Next()
-
-		if value.IsNone() {
-			break
-		}
-
-		if f(value.Unwrap()) {
-			newList.PushBack(value.Unwrap())
-		}
+// next_back
+func (self *LinkedListIter[T]) NextBack() Option[T] {
+	if self.len == 0 {
+		return None[T]()
 	}
 
-	return newList.IntoIter()
-}
-
-// fold
-func (self LinkedListIter[T]) Fold(init T, f func(T, T) T) T {
-	currentIter := self
+	value := self.backPointer.value
+	self.backPointer = self.backPointer.prev
+	self.len--
 
-	result := init
-
-	for {
-		value := currentIter.Next()
-
-		if value.IsNone() {
-			break
-		}
-
-		result = f(result, value.Unwrap())
-	}
-
-	return result
+	return Some[T](value)
 }
 
-// rev
-func (self LinkedListIter[T]) Rev() Iterator[T] {
-	currentIter := self
-
-	newList := LinkedListNew[T]()
+// Extends a collection with the contents of an iterator.
+//
+//	list := gost.LinkedListNew[gost.I32]()
+//	vec := gost.VecNew[gost.I32]()
+//	vec.Push(1)
+//	list.Extend(vec)
+//	gost.AssertEq(list.Len(), gost.USize(1))
+func (self *LinkedList[T]) Extend(iter IntoIterator[T]) {
+	iterator := iter.IntoIter()
 
 	for {
-		value := currentIter.Next()
+		value := iterator.Next()
 
 		if value.IsNone() {
-			break
+			return
 		}
 
-		newList.PushBack(value.Unwrap())
+		self.PushBack(value.Unwrap())
 	}
-
-	return newList.IntoIter()
 }
 
-// Collect To Vec
-func (self LinkedListIter[T]) CollectToVec() Vec[T] {
-	vec := Vec[T]{}
-	for {
-		value := self.Next()
-		if value.IsNone() {
-			return vec
-		}
-		vec.Push(value.Unwrap())
-	}
-}
-
-// Collect To LinkedList
-func (self LinkedListIter[T]) CollectToLinkedList() LinkedList[T] {
-	list := LinkedListNew[T]()
-	for {
-		value := self.Next()
-		if value.IsNone() {
-			return list
-		}
-		list.PushBack(value.Unwrap())
-	}
+// impl FromIterator for LinkedList
+func (self *LinkedList[T]) FromIter(iter Iterator[T]) {
+	*self = LinkedListNew[T]()
+	self.Extend(iter)
 }
 
 // impl Display for LinkedList
@@ -4,6 +4,7 @@ import (
 	"encoding/binary"
 	"math"
 	"math/big"
+	"reflect"
 )
 
 // Add is a trait for types that support addition.
@@ -60,6 +61,26 @@ type Neg[T any] interface {
 	Neg() T
 }
 
+func castToAdd[T any](value T) _InternalOption[Add[T]] {
+	reflectedValue := reflect.ValueOf(value)
+
+	if casted, ok := reflectedValue.Interface().(Add[T]); ok {
+		return _Some[Add[T]](casted)
+	} else {
+		return _None[Add[T]]()
+	}
+}
+
+func castToMul[T any](value T) _InternalOption[Mul[T]] {
+	reflectedValue := reflect.ValueOf(value)
+
+	if casted, ok := reflectedValue.Interface().(Mul[T]); ok {
+		return _Some[Mul[T]](casted)
+	} else {
+		return _None[Mul[T]]()
+	}
+}
+
 // Add implements
 func (self ISize) Add(rhs ISize) ISize {
 	return self + rhs
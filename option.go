@@ -146,10 +146,30 @@ func (self Option[T]) MapOrElse(defaultValue func() T, f func(T) T) T {
 
 // Transforms the Option<T> into a Result<T, E>, mapping Some(v) to Ok(v) and None to Err(err).
 // Arguments passed to ok_or are eagerly evaluated; if you are passing the result of a function call, it is recommended to use ok_or_else, which is lazily evaluated.
-// TODO ok_or
+//
+//	none := errors.New("none")
+//	gost.AssertEq(gost.Some(gost.I32(2)).OkOr(none), gost.Ok(gost.I32(2)))
+//	gost.Assert(gost.None[gost.I32]().OkOr(none).UnwrapErr() == none)
+func (self Option[T]) OkOr(err error) Result[T] {
+	if self.IsNone() {
+		return Err[T](err)
+	} else {
+		return Ok[T](*self.value)
+	}
+}
 
 // Transforms the Option<T> into a Result<T, E>, mapping Some(v) to Ok(v) and None to Err(err()).
-// TODO ok_or_else
+//
+//	none := errors.New("none")
+//	gost.AssertEq(gost.Some(gost.I32(2)).OkOrElse(func() error { return none }), gost.Ok(gost.I32(2)))
+//	gost.Assert(gost.None[gost.I32]().OkOrElse(func() error { return none }).UnwrapErr() == none)
+func (self Option[T]) OkOrElse(err func() error) Result[T] {
+	if self.IsNone() {
+		return Err[T](err())
+	} else {
+		return Ok[T](*self.value)
+	}
+}
 
 // TODO iter
 
@@ -173,7 +193,7 @@ func (self Option[T]) MapOrElse(defaultValue func() T, f func(T) T) T {
 //	gost.AssertEq(x.and(y), Some(gost.I32(3)));
 func (self Option[T]) And(optb Option[any]) Option[any] {
 	if self.IsNone() {
-		return Some[any](nil)
+		return None[any]()
 	} else {
 		return optb
 	}
@@ -189,7 +209,7 @@ func (self Option[T]) And(optb Option[any]) Option[any] {
 //	gost.AssertEq(x.and_then(func(value gost.I32) Option[gost.I32] { return Some[gost.I32](value + 1) }), None[gost.I32]())
 func (self Option[T]) AndThen(f func(T) Option[any]) Option[any] {
 	if self.IsNone() {
-		return Some[any](nil)
+		return None[any]()
 	} else {
 		return f(*self.value)
 	}
@@ -341,6 +361,112 @@ func (self *Option[T]) Replace(value T) Option[T] {
 	}
 }
 
+// Maps an Option<T> to Option<U> by applying a function to a contained value (if Some) or returns None (if None).
+//
+//	x := gost.Some(gost.I32(2))
+//	gost.AssertEq(gost.OptionMap(x, func(value gost.I32) gost.String { return value.ToString() }), gost.Some[gost.String]("2"))
+func OptionMap[T any, U any](option Option[T], f func(T) U) Option[U] {
+	if option.IsNone() {
+		return None[U]()
+	} else {
+		return Some[U](f(*option.value))
+	}
+}
+
+// Returns the provided default result (if none), or applies a function to the contained value (if any).
+//
+//	x := gost.None[gost.I32]()
+//	gost.AssertEq(gost.OptionMapOr(x, gost.String("none"), func(value gost.I32) gost.String { return value.ToString() }), gost.String("none"))
+func OptionMapOr[T any, U any](option Option[T], defaultValue U, f func(T) U) U {
+	if option.IsNone() {
+		return defaultValue
+	} else {
+		return f(*option.value)
+	}
+}
+
+// Computes a default function result (if none), or applies a different function to the contained value (if any).
+//
+//	x := gost.Some(gost.I32(2))
+//	gost.AssertEq(gost.OptionMapOrElse(x, func() gost.String { return "none" }, func(value gost.I32) gost.String { return value.ToString() }), gost.String("2"))
+func OptionMapOrElse[T any, U any](option Option[T], defaultValue func() U, f func(T) U) U {
+	if option.IsNone() {
+		return defaultValue()
+	} else {
+		return f(*option.value)
+	}
+}
+
+// Returns None if the option is None, otherwise returns optb.
+//
+//	x := gost.Some(gost.I32(2))
+//	gost.AssertEq(gost.OptionAnd(x, gost.Some[gost.String]("a")), gost.Some[gost.String]("a"))
+func OptionAnd[T any, U any](option Option[T], optb Option[U]) Option[U] {
+	if option.IsNone() {
+		return None[U]()
+	} else {
+		return optb
+	}
+}
+
+// Returns None if the option is None, otherwise calls f with the wrapped value and returns the result.
+// Some languages call this operation flatmap.
+//
+//	x := gost.Some(gost.String("2"))
+//	gost.AssertEq(gost.OptionAndThen(x, func(value gost.String) gost.Option[gost.I64] { return value.ParseI64().Ok() }), gost.Some[gost.I64](2))
+func OptionAndThen[T any, U any](option Option[T], f func(T) Option[U]) Option[U] {
+	if option.IsNone() {
+		return None[U]()
+	} else {
+		return f(*option.value)
+	}
+}
+
+// Zips self with another Option.
+// If self is Some(s) and other is Some(o), this method returns Some(Pair{s, o}). Otherwise, None is returned.
+//
+//	x := gost.Some(gost.I32(1))
+//	y := gost.Some(gost.String("hi"))
+//	gost.AssertEq(gost.OptionZip(x, y), gost.Some(gost.Pair[gost.I32, gost.String]{Key: 1, Value: "hi"}))
+//	gost.AssertEq(gost.OptionZip(x, gost.None[gost.String]()), gost.None[gost.Pair[gost.I32, gost.String]]())
+func OptionZip[T any, U any](option Option[T], other Option[U]) Option[Pair[T, U]] {
+	if option.IsNone() || other.IsNone() {
+		return None[Pair[T, U]]()
+	} else {
+		return Some(Pair[T, U]{Key: *option.value, Value: *other.value})
+	}
+}
+
+// Converts from Option<Option<T>> to Option<T>.
+//
+//	x := gost.Some(gost.Some(gost.I32(6)))
+//	gost.AssertEq(gost.OptionFlatten(x), gost.Some[gost.I32](6))
+func OptionFlatten[T any](option Option[Option[T]]) Option[T] {
+	if option.IsNone() {
+		return None[T]()
+	} else {
+		return *option.value
+	}
+}
+
+// Transposes an Option of a Result into a Result of an Option.
+// None will be mapped to Ok(None). Some(Ok(_)) and Some(Err(_)) will be mapped to Ok(Some(_)) and Err(_).
+//
+//	x := gost.Some(gost.Ok[gost.I32](5))
+//	gost.AssertEq(gost.OptionTranspose(x), gost.Ok(gost.Some[gost.I32](5)))
+func OptionTranspose[T any](option Option[Result[T]]) Result[Option[T]] {
+	if option.IsNone() {
+		return Ok(None[T]())
+	}
+
+	result := *option.value
+	if result.IsErr() {
+		return _ErrFrom[Option[T]](result)
+	} else {
+		return Ok(Some[T](*result.ok))
+	}
+}
+
 // impl Display for Option
 func (self Option[T]) Display() String {
 	if self.IsNone() {
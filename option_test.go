@@ -0,0 +1,55 @@
+package gost
+
+import (
+	"errors"
+	"testing"
+)
+
+func Test_OptionMap(t *testing.T) {
+	t.Parallel()
+
+	AssertEq(OptionMap(Some[I32](2), func(value I32) String { return value.ToString() }), Some[String]("2"), "OptionMap Some")
+	AssertEq(OptionMap(None[I32](), func(value I32) String { return value.ToString() }), None[String](), "OptionMap None")
+	AssertEq(OptionMapOr(None[I32](), String("none"), func(value I32) String { return value.ToString() }), String("none"), "OptionMapOr")
+	AssertEq(OptionAnd(None[I32](), Some[String]("a")), None[String](), "OptionAnd")
+	AssertEq(OptionAndThen(Some[String]("12"), func(value String) Option[I64] { return value.ParseI64().Ok() }), Some[I64](12), "OptionAndThen")
+	AssertEq(OptionAndThen(Some[String]("x"), func(value String) Option[I64] { return value.ParseI64().Ok() }), None[I64](), "OptionAndThen None")
+}
+
+func Test_Option_And_None(t *testing.T) {
+	t.Parallel()
+
+	Assert(None[I32]().And(Some[any](1)).IsNone(), "And on None returns None, not Some(nil)")
+	Assert(None[I32]().AndThen(func(value I32) Option[any] { return Some[any](value) }).IsNone(), "AndThen on None returns None, not Some(nil)")
+	AssertEq(Some[I32](2).AndThen(func(value I32) Option[any] { return Some[any](value + 1) }).Unwrap().(I32), I32(3), "AndThen on Some")
+}
+
+func Test_OptionZipFlatten(t *testing.T) {
+	t.Parallel()
+
+	zipped := OptionZip(Some[I32](1), Some[String]("a"))
+	AssertEq(zipped.Unwrap().Key, I32(1), "OptionZip key")
+	AssertEq(zipped.Unwrap().Value, String("a"), "OptionZip value")
+	Assert(OptionZip(Some[I32](1), None[String]()).IsNone(), "OptionZip None")
+
+	AssertEq(OptionFlatten(Some(Some[I32](6))), Some[I32](6), "OptionFlatten")
+	AssertEq(OptionFlatten(Some(None[I32]())), None[I32](), "OptionFlatten inner None")
+}
+
+func Test_OptionTranspose(t *testing.T) {
+	t.Parallel()
+
+	AssertEq(OptionTranspose(Some(Ok[I32](5))), Ok(Some[I32](5)), "OptionTranspose Some(Ok)")
+	AssertEq(OptionTranspose(None[Result[I32]]()), Ok(None[I32]()), "OptionTranspose None")
+	Assert(OptionTranspose(Some(Err[I32](errors.New("error")))).IsErr(), "OptionTranspose Some(Err)")
+}
+
+func Test_OkOr(t *testing.T) {
+	t.Parallel()
+
+	err := errors.New("none")
+
+	AssertEq(Some[I32](2).OkOr(err), Ok[I32](2), "OkOr Some")
+	Assert(None[I32]().OkOr(err).UnwrapErr() == err, "OkOr None")
+	Assert(None[I32]().OkOrElse(func() error { return err }).UnwrapErr() == err, "OkOrElse None")
+}
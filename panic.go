@@ -1,5 +1,10 @@
 package gost
 
+import (
+	"fmt"
+	"runtime/debug"
+)
+
 // Panics the current thread.
 //
 //  gost.Panic("This is a panic")
@@ -14,7 +19,7 @@ func Assert(condition Bool, args ...any) {
 	if !condition {
 		if len(args) > 0 {
 			if message, ok := args[0].(String); ok {
-				panic(Format(message, args...))
+				panic(Format(message, args[1:]...))
 			}
 		}
 
@@ -31,11 +36,11 @@ func AssertEq[T Eq[T]](lhs T, rhs T, args ...any) {
 
 		if len(args) > 0 {
 			if message, ok := args[0].(String); ok {
-				panicMessage += "\n>>> " + Format(message, args...)
+				panicMessage += "\n>>> " + Format(message, args[1:]...)
 			}
 
 			if message, ok := args[0].(string); ok {
-				panicMessage += "\n>>> " + Format(String(message), args...)
+				panicMessage += "\n>>> " + Format(String(message), args[1:]...)
 			}
 		}
 
@@ -52,11 +57,11 @@ func AssertNe[T Eq[T]](lhs T, rhs T, args ...any) {
 
 		if len(args) > 0 {
 			if message, ok := args[0].(String); ok {
-				panicMessage += "\n>>> " + Format(message, args...)
+				panicMessage += "\n>>> " + Format(message, args[1:]...)
 			}
 
 			if message, ok := args[0].(string); ok {
-				panicMessage += "\n>>> " + Format(String(message), args...)
+				panicMessage += "\n>>> " + Format(String(message), args[1:]...)
 			}
 		}
 
@@ -96,3 +101,67 @@ func Todo(messages ...String) {
 		panic(messages[0])
 	}
 }
+
+// The error of a panic caught by CatchUnwind, carrying the panic payload and the stack of the panicking goroutine.
+type PanicError struct {
+	payload any
+	stack   String
+}
+
+// Returns the value that was passed to panic.
+func (self PanicError) Payload() any {
+	return self.payload
+}
+
+// Returns the stack trace of the goroutine at the time it panicked.
+func (self PanicError) Stack() String {
+	return self.stack
+}
+
+// impl error for PanicError
+func (self PanicError) Error() string {
+	if err, ok := self.payload.(error); ok {
+		return "panicked: " + err.Error()
+	}
+
+	return fmt.Sprintf("panicked: %v", self.payload)
+}
+
+// Unwrap returns the payload if it is an error, for errors.Is and errors.As.
+func (self PanicError) Unwrap() error {
+	if err, ok := self.payload.(error); ok {
+		return err
+	}
+
+	return nil
+}
+
+// impl Display for PanicError
+func (self PanicError) Display() String {
+	return String(self.Error())
+}
+
+// impl Debug for PanicError
+func (self PanicError) Debug() String {
+	return String(self.Error()) + "\n\n" + self.stack
+}
+
+// Invokes f, capturing the cause of a panic if one occurs.
+// Returns Ok with the result of f, or an Err of PanicError with the panic payload and stack.
+//
+//	result := gost.CatchUnwind(func() gost.I32 { panic("oh no") })
+//	gost.Assert(result.IsErr())
+func CatchUnwind[T any](f func() T) (result Result[T]) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			// A panic propagated by Await already carries the payload and stack of where it started.
+			if panicError, ok := recovered.(PanicError); ok {
+				result = Err[T](panicError)
+			} else {
+				result = Err[T](PanicError{payload: recovered, stack: String(debug.Stack())})
+			}
+		}
+	}()
+
+	return Ok(f())
+}
@@ -0,0 +1,534 @@
+package gost
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// A slice of a path, modeled on Rust's std::path::Path.
+// Path is a string type, so string literals can be used where a Path is expected.
+//
+//	path := gost.Path("/tmp/foo.txt")
+//	gost.AssertEq(path.Extension(), gost.Some[gost.String]("txt"))
+type Path String
+
+// An owned, mutable path, modeled on Rust's std::path::PathBuf.
+// Every method of Path is available on a PathBuf.
+//
+//	path := gost.PathBufFrom("/tmp")
+//	path.Push("foo.txt")
+//	gost.AssertEq(path.FileName(), gost.Some[gost.String]("foo.txt"))
+type PathBuf struct {
+	Path
+}
+
+// Types that can be used as a path: String, Path, PathBuf and string.
+// Every function of fs.go and gokio/fs.go accepts any of them.
+type AsPath interface {
+	String | Path | PathBuf | string
+}
+
+// Converts any AsPath value to a Path.
+//
+//	gost.AssertEq(gost.PathOf(gost.String("a/b")), gost.Path("a/b"))
+func PathOf[P AsPath](path P) Path {
+	switch value := any(path).(type) {
+	case String:
+		return Path(value)
+	case Path:
+		return value
+	case PathBuf:
+		return value.Path
+	default:
+		return Path(any(path).(string))
+	}
+}
+
+func _PathString[P AsPath](path P) string {
+	return string(PathOf(path))
+}
+
+// The kind of a Component.
+type ComponentKind int
+
+// ComponentKind enum values
+const (
+	// A Windows path prefix, such as "C:".
+	ComponentPrefix ComponentKind = iota
+	// The root directory component, a separator after the prefix.
+	ComponentRootDir
+	// A reference to the current directory, ".". It only appears at the start of a path.
+	ComponentCurDir
+	// A reference to the parent directory, "..".
+	ComponentParentDir
+	// A normal component, such as "a" and "b" in "a/b".
+	ComponentNormal
+)
+
+// A single component of a path, yielded by Path.Components.
+type Component struct {
+	kind  ComponentKind
+	value String
+}
+
+// Returns the kind of this component.
+func (self Component) Kind() ComponentKind {
+	return self.kind
+}
+
+// Returns this component as a Path. The root directory is the path separator.
+func (self Component) AsPath() Path {
+	return Path(self.value)
+}
+
+// impl Display for Component
+func (self Component) Display() String {
+	return self.value
+}
+
+// impl Debug for Component
+func (self Component) Debug() String {
+	switch self.kind {
+	case ComponentPrefix:
+		return String(fmt.Sprintf("Prefix(%s)", self.value))
+	case ComponentRootDir:
+		return "RootDir"
+	case ComponentCurDir:
+		return "CurDir"
+	case ComponentParentDir:
+		return "ParentDir"
+	default:
+		return String(fmt.Sprintf("Normal(%s)", self.value))
+	}
+}
+
+// impl Eq for Component
+func (self Component) Eq(other Component) Bool {
+	return self.kind == other.kind && self.value == other.value
+}
+
+// Parses the path into its components. Repeated separators and "." components after the first are dropped, and so is a trailing separator.
+func (self Path) components() []Component {
+	path := string(self)
+	components := []Component{}
+
+	volume := filepath.VolumeName(path)
+	if volume != "" {
+		components = append(components, Component{kind: ComponentPrefix, value: String(volume)})
+		path = path[len(volume):]
+	}
+
+	if len(path) > 0 && os.IsPathSeparator(path[0]) {
+		components = append(components, Component{kind: ComponentRootDir, value: String(filepath.Separator)})
+	}
+
+	parts := strings.FieldsFunc(path, func(r rune) bool { return r < 128 && os.IsPathSeparator(uint8(r)) })
+
+	for i, part := range parts {
+		switch part {
+		case ".":
+			if i == 0 && len(components) == 0 {
+				components = append(components, Component{kind: ComponentCurDir, value: "."})
+			}
+		case "..":
+			components = append(components, Component{kind: ComponentParentDir, value: ".."})
+		default:
+			components = append(components, Component{kind: ComponentNormal, value: String(part)})
+		}
+	}
+
+	return components
+}
+
+// Builds a path from components, the inverse of components.
+func _PathFromComponents(components []Component) Path {
+	builder := strings.Builder{}
+	needsSeparator := false
+
+	for _, component := range components {
+		switch component.kind {
+		case ComponentPrefix:
+			builder.WriteString(string(component.value))
+		case ComponentRootDir:
+			builder.WriteString(string(component.value))
+			needsSeparator = false
+		default:
+			if needsSeparator {
+				builder.WriteByte(filepath.Separator)
+			}
+			builder.WriteString(string(component.value))
+			needsSeparator = true
+		}
+	}
+
+	return Path(builder.String())
+}
+
+// Produces an iterator over the Components of the path.
+//
+//	iter := gost.Path("/tmp//foo/./bar").Components()
+//	gost.AssertEq(iter.Count(), gost.USize(4)) // RootDir, "tmp", "foo", "bar"
+func (self Path) Components() Iterator[Component] {
+	components := Vec[Component]{data: self.components()}
+	return components.IntoIter()
+}
+
+// Creates an owned PathBuf with path adjoined to self.
+// If path is absolute, it replaces the current path.
+//
+//	gost.AssertEq(gost.Path("/etc").Join("passwd"), gost.PathBufFrom("/etc/passwd"))
+func (self Path) Join(path Path) PathBuf {
+	buffer := self.ToPathBuf()
+	buffer.Push(path)
+	return buffer
+}
+
+// Returns the path without its final component, if there is one.
+// Returns None if the path terminates in a root or prefix, or if it is the empty string.
+//
+//	gost.AssertEq(gost.Path("/foo/bar").Parent(), gost.Some[gost.Path]("/foo"))
+//	gost.AssertEq(gost.Path("foo").Parent(), gost.Some[gost.Path](""))
+//	gost.AssertEq(gost.Path("/").Parent(), gost.None[gost.Path]())
+func (self Path) Parent() Option[Path] {
+	components := self.components()
+
+	if len(components) == 0 {
+		return None[Path]()
+	}
+
+	switch components[len(components)-1].kind {
+	case ComponentNormal, ComponentCurDir, ComponentParentDir:
+		return Some(_PathFromComponents(components[:len(components)-1]))
+	default:
+		return None[Path]()
+	}
+}
+
+// Returns the final component of the path, if it is a normal file or directory name.
+// Returns None if the path terminates in "..".
+//
+//	gost.AssertEq(gost.Path("/usr/bin/").FileName(), gost.Some[gost.String]("bin"))
+func (self Path) FileName() Option[String] {
+	components := self.components()
+
+	if len(components) == 0 || components[len(components)-1].kind != ComponentNormal {
+		return None[String]()
+	}
+
+	return Some(components[len(components)-1].value)
+}
+
+// Splits the file name into its stem and extension. A leading "." does not start an extension.
+func _SplitFileName(name String) (String, Option[String]) {
+	index := strings.LastIndexByte(string(name), '.')
+
+	if index <= 0 {
+		return name, None[String]()
+	}
+
+	return name[:index], Some(name[index+1:])
+}
+
+// Extracts the stem (non-extension) portion of FileName.
+//
+//	gost.AssertEq(gost.Path("foo.tar.gz").FileStem(), gost.Some[gost.String]("foo.tar"))
+//	gost.AssertEq(gost.Path(".bashrc").FileStem(), gost.Some[gost.String](".bashrc"))
+func (self Path) FileStem() Option[String] {
+	name := self.FileName()
+
+	if name.IsNone() {
+		return None[String]()
+	}
+
+	stem, _ := _SplitFileName(name.Unwrap())
+	return Some(stem)
+}
+
+// Extracts the extension of FileName, without the leading ".".
+//
+//	gost.AssertEq(gost.Path("foo.tar.gz").Extension(), gost.Some[gost.String]("gz"))
+//	gost.AssertEq(gost.Path(".bashrc").Extension(), gost.None[gost.String]())
+func (self Path) Extension() Option[String] {
+	name := self.FileName()
+
+	if name.IsNone() {
+		return None[String]()
+	}
+
+	_, extension := _SplitFileName(name.Unwrap())
+	return extension
+}
+
+// Creates an owned PathBuf like self but with the given extension. See PathBuf.SetExtension.
+//
+//	gost.AssertEq(gost.Path("foo.rs").WithExtension("txt"), gost.PathBufFrom("foo.txt"))
+func (self Path) WithExtension(extension String) PathBuf {
+	buffer := self.ToPathBuf()
+	buffer.SetExtension(extension)
+	return buffer
+}
+
+// Creates an owned PathBuf like self but with the given file name. See PathBuf.SetFileName.
+func (self Path) WithFileName(name String) PathBuf {
+	buffer := self.ToPathBuf()
+	buffer.SetFileName(name)
+	return buffer
+}
+
+// Returns true if the path is absolute, independent of the current directory.
+func (self Path) IsAbsolute() Bool {
+	return Bool(filepath.IsAbs(string(self)))
+}
+
+// Returns true if the path is not absolute.
+func (self Path) IsRelative() Bool {
+	return !self.IsAbsolute()
+}
+
+// Returns true if the path has a root, such as "/" or "C:\".
+func (self Path) HasRoot() Bool {
+	for _, component := range self.components() {
+		if component.kind == ComponentRootDir {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Determines whether base is a prefix of self. Only whole components match.
+//
+//	gost.Assert(gost.Path("/etc/passwd").StartsWith("/etc"))
+//	gost.Assert(!gost.Path("/etc/passwd").StartsWith("/e"))
+func (self Path) StartsWith(base Path) Bool {
+	return self.StripPrefix(base).IsOk()
+}
+
+// Determines whether child is a suffix of self. Only whole components match.
+func (self Path) EndsWith(child Path) Bool {
+	components := self.components()
+	suffix := child.components()
+
+	if len(suffix) > len(components) {
+		return false
+	}
+
+	return Bool(_ComponentsEq(components[len(components)-len(suffix):], suffix))
+}
+
+// Returns a path that, when joined onto base, yields self.
+// Returns an Err of StripPrefixError if base is not a prefix of self.
+//
+//	gost.AssertEq(gost.Path("/test/haha/foo.txt").StripPrefix("/test"), gost.Ok[gost.Path]("haha/foo.txt"))
+func (self Path) StripPrefix(base Path) Result[Path] {
+	components := self.components()
+	prefix := base.components()
+
+	if len(prefix) > len(components) || !_ComponentsEq(components[:len(prefix)], prefix) {
+		return Err[Path](StripPrefixError{})
+	}
+
+	return Ok(_PathFromComponents(components[len(prefix):]))
+}
+
+func _ComponentsEq(a []Component, b []Component) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if !a[i].Eq(b[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Returns true if the path points at an existing entity. Symbolic links are followed.
+func (self Path) Exists() Bool {
+	return MetadataOf(self).IsOk()
+}
+
+// Returns true if the path exists on disk and is pointing at a regular file. Symbolic links are followed.
+func (self Path) IsFile() Bool {
+	metadata := MetadataOf(self)
+	return metadata.IsOk() && Bool(metadata.Unwrap().IsFile())
+}
+
+// Returns true if the path exists on disk and is pointing at a directory. Symbolic links are followed.
+func (self Path) IsDir() Bool {
+	metadata := MetadataOf(self)
+	return metadata.IsOk() && Bool(metadata.Unwrap().IsDir())
+}
+
+// Converts the path to an owned PathBuf.
+func (self Path) ToPathBuf() PathBuf {
+	return PathBuf{Path: self}
+}
+
+// Returns the path as a String.
+func (self Path) ToString() String {
+	return String(self)
+}
+
+// impl Display for Path
+func (self Path) Display() String {
+	return String(self)
+}
+
+// impl Debug for Path
+func (self Path) Debug() String {
+	return String(fmt.Sprintf("Path(%s)", self))
+}
+
+// impl Eq for Path
+// Paths are compared by their components, so "a//b/" equals "a/b".
+func (self Path) Eq(other Path) Bool {
+	return Bool(_ComponentsEq(self.components(), other.components()))
+}
+
+// impl Ord for Path
+// Paths are compared component by component.
+func (self Path) Cmp(other Path) Ordering {
+	components := self.components()
+	otherComponents := other.components()
+
+	for i := 0; i < len(components) && i < len(otherComponents); i++ {
+		if components[i].kind != otherComponents[i].kind {
+			return ISize(components[i].kind).Cmp(ISize(otherComponents[i].kind))
+		}
+
+		if ordering := components[i].value.Cmp(otherComponents[i].value); ordering != OrderingEqual {
+			return ordering
+		}
+	}
+
+	return USize(len(components)).Cmp(USize(len(otherComponents)))
+}
+
+// impl Clone for Path
+func (self Path) Clone() Path {
+	return self
+}
+
+// An error returned from Path.StripPrefix if the prefix was not found.
+type StripPrefixError struct{}
+
+// impl error for StripPrefixError
+func (self StripPrefixError) Error() string {
+	return "prefix not found"
+}
+
+// Allocates an empty PathBuf.
+func PathBufNew() PathBuf {
+	return PathBuf{Path: ""}
+}
+
+// Creates a PathBuf from a String, Path, PathBuf or string.
+func PathBufFrom[P AsPath](path P) PathBuf {
+	return PathBuf{Path: PathOf(path)}
+}
+
+// Coerces to a Path slice.
+func (self PathBuf) AsPath() Path {
+	return self.Path
+}
+
+// Extends self with path. If path is absolute, it replaces the current path.
+//
+//	path := gost.PathBufFrom("/tmp")
+//	path.Push("file.bk")
+//	gost.AssertEq(path, gost.PathBufFrom("/tmp/file.bk"))
+func (self *PathBuf) Push(path Path) {
+	if path.IsAbsolute() {
+		self.Path = path
+		return
+	}
+
+	// A rooted path without a prefix keeps the prefix of self, like "\\windows" pushed onto "C:\\temp".
+	if path.HasRoot() {
+		self.Path = Path(filepath.VolumeName(string(self.Path))) + path
+		return
+	}
+
+	if len(self.Path) > 0 && !os.IsPathSeparator(self.Path[len(self.Path)-1]) {
+		self.Path += Path(filepath.Separator)
+	}
+
+	self.Path += path
+}
+
+// Truncates self to self.Parent. Returns false and does nothing if there is no parent.
+func (self *PathBuf) Pop() Bool {
+	parent := self.Parent()
+
+	if parent.IsNone() {
+		return false
+	}
+
+	self.Path = parent.Unwrap()
+	return true
+}
+
+// Updates self.FileName to name. If there is no file name, this is equivalent to pushing name.
+//
+//	path := gost.PathBufFrom("/tmp/foo.txt")
+//	path.SetFileName("bar.txt")
+//	gost.AssertEq(path, gost.PathBufFrom("/tmp/bar.txt"))
+func (self *PathBuf) SetFileName(name String) {
+	if self.FileName().IsSome() {
+		self.Pop()
+	}
+
+	self.Push(Path(name))
+}
+
+// Updates self.Extension to extension, or removes the extension if it is empty.
+// Returns false and does nothing if there is no file name.
+//
+//	path := gost.PathBufFrom("/feel/the")
+//	path.SetExtension("force")
+//	gost.AssertEq(path, gost.PathBufFrom("/feel/the.force"))
+func (self *PathBuf) SetExtension(extension String) Bool {
+	stem := self.FileStem()
+
+	if stem.IsNone() {
+		return false
+	}
+
+	name := stem.Unwrap()
+	if extension != "" {
+		name += "." + extension
+	}
+
+	self.SetFileName(name)
+	return true
+}
+
+// impl Display for PathBuf
+func (self PathBuf) Display() String {
+	return String(self.Path)
+}
+
+// impl Debug for PathBuf
+func (self PathBuf) Debug() String {
+	return String(fmt.Sprintf("PathBuf(%s)", self.Path))
+}
+
+// impl Eq for PathBuf
+func (self PathBuf) Eq(other PathBuf) Bool {
+	return self.Path.Eq(other.Path)
+}
+
+// impl Ord for PathBuf
+func (self PathBuf) Cmp(other PathBuf) Ordering {
+	return self.Path.Cmp(other.Path)
+}
+
+// impl Clone for PathBuf
+func (self PathBuf) Clone() PathBuf {
+	return self
+}
@@ -0,0 +1,95 @@
+//go:build !windows
+
+package gost
+
+import "testing"
+
+func Test_Path_Components(t *testing.T) {
+	t.Parallel()
+
+	components := VecNew[String]()
+	iter := Path("/tmp//foo/./bar/../baz/").Components()
+
+	for component := iter.Next(); component.IsSome(); component = iter.Next() {
+		components.Push(component.Unwrap().Debug())
+	}
+
+	expected := VecNew[String]()
+	for _, component := range []String{"RootDir", "Normal(tmp)", "Normal(foo)", "Normal(bar)", "ParentDir", "Normal(baz)"} {
+		expected.Push(component)
+	}
+	AssertEq(components, expected, "Components")
+
+	Assert(Bool(Path("./a").Components().Next().Unwrap().Kind() == ComponentCurDir), "leading CurDir")
+	AssertEq(Path("a/./b").Components().Count(), USize(2), "inner CurDir is dropped")
+	AssertEq(Path("a//b/"), Path("a/b"), "paths compare by components")
+}
+
+func Test_Path_Parts(t *testing.T) {
+	t.Parallel()
+
+	AssertEq(Path("/foo/bar").Parent(), Some[Path]("/foo"), "Parent")
+	AssertEq(Path("/foo").Parent(), Some[Path]("/"), "Parent of a root child")
+	AssertEq(Path("foo").Parent(), Some[Path](""), "Parent of a relative name")
+	AssertEq(Path("/").Parent(), None[Path](), "Parent of the root")
+	AssertEq(Path("").Parent(), None[Path](), "Parent of the empty path")
+
+	AssertEq(Path("/usr/bin/").FileName(), Some[String]("bin"), "FileName")
+	AssertEq(Path("foo/..").FileName(), None[String](), "FileName of ..")
+
+	AssertEq(Path("foo.tar.gz").FileStem(), Some[String]("foo.tar"), "FileStem")
+	AssertEq(Path("foo.tar.gz").Extension(), Some[String]("gz"), "Extension")
+	AssertEq(Path(".bashrc").FileStem(), Some[String](".bashrc"), "FileStem of a dotfile")
+	AssertEq(Path(".bashrc").Extension(), None[String](), "Extension of a dotfile")
+
+	AssertEq(Path("/etc").Join("passwd"), PathBufFrom("/etc/passwd"), "Join")
+	AssertEq(Path("/etc/").Join("passwd").Display(), String("/etc/passwd"), "Join does not double the separator")
+	AssertEq(Path("/etc").Join("/usr"), PathBufFrom("/usr"), "Join an absolute path")
+
+	AssertEq(Path("a/foo.rs").WithExtension("txt"), PathBufFrom("a/foo.txt"), "WithExtension")
+	AssertEq(Path("a/foo.rs").WithExtension(""), PathBufFrom("a/foo"), "WithExtension removes the extension")
+	AssertEq(Path("a/foo.rs").WithFileName("bar"), PathBufFrom("a/bar"), "WithFileName")
+
+	Assert(Path("/a").IsAbsolute(), "IsAbsolute")
+	Assert(Path("a").IsRelative(), "IsRelative")
+}
+
+func Test_Path_StripPrefix(t *testing.T) {
+	t.Parallel()
+
+	path := Path("/test/haha/foo.txt")
+
+	AssertEq(path.StripPrefix("/"), Ok[Path]("test/haha/foo.txt"), "StripPrefix root")
+	AssertEq(path.StripPrefix("/test/"), Ok[Path]("haha/foo.txt"), "StripPrefix with a trailing separator")
+	AssertEq(path.StripPrefix("/test/haha/foo.txt"), Ok[Path](""), "StripPrefix itself")
+	Assert(path.StripPrefix("/te").IsErr(), "StripPrefix matches whole components")
+	Assert(path.StripPrefix("test").IsErr(), "StripPrefix of a relative path")
+
+	Assert(path.StartsWith("/test"), "StartsWith")
+	Assert(path.EndsWith("haha/foo.txt"), "EndsWith")
+	Assert(!path.EndsWith("a/foo.txt"), "EndsWith matches whole components")
+}
+
+func Test_PathBuf(t *testing.T) {
+	t.Parallel()
+
+	path := PathBufFrom("/tmp")
+	path.Push("file.bk")
+	AssertEq(path, PathBufFrom("/tmp/file.bk"), "Push")
+
+	Assert(path.SetExtension("txt"), "SetExtension")
+	AssertEq(path, PathBufFrom("/tmp/file.txt"), "SetExtension")
+
+	path.SetFileName("other")
+	AssertEq(path, PathBufFrom("/tmp/other"), "SetFileName")
+
+	Assert(path.Pop(), "Pop")
+	Assert(path.Pop(), "Pop")
+	Assert(!path.Pop(), "Pop the root")
+	AssertEq(path, PathBufFrom("/"), "Pop")
+	Assert(!path.SetExtension("txt"), "SetExtension without a file name")
+
+	AssertEq(PathOf(String("a")), Path("a"), "PathOf String")
+	AssertEq(PathOf(PathBufFrom("a")), Path("a"), "PathOf PathBuf")
+	AssertEq(PathOf("a"), Path("a"), "PathOf string")
+}
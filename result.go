@@ -9,8 +9,9 @@ import (
 // Result<T> is the type used for returning and propagating errors.
 // It is an enum with the variants, Ok(T), representing success and containing a value, and Err, representing error and containing an error value.
 type Result[T any] struct {
-	ok  *T
-	err error
+	ok        *T
+	err       error
+	backtrace Option[Backtrace]
 }
 
 // Creates a new Result<T> containing an Ok value.
@@ -19,10 +20,20 @@ func Ok[T any](value T) Result[T] {
 }
 
 // Creates a new Result<T> containing an Err value.
+// If backtraces are enabled and err does not carry one already, a Backtrace of the caller is captured.
 func Err[T any](err error) Result[T] {
+	if BacktraceEnabled() && _ErrorBacktrace(err).IsNone() {
+		return Result[T]{err: err, backtrace: Some(_CaptureBacktrace(3))}
+	}
+
 	return Result[T]{err: err}
 }
 
+// Converts an Err of one Result type into an Err of another, keeping the error and its backtrace.
+func _ErrFrom[U any, T any](result Result[T]) Result[U] {
+	return Result[U]{err: result.err, backtrace: result.backtrace}
+}
+
 // Returns true if the result is Ok.
 //
 //	x := gost.Ok[gost.I32](gost.I32(2))
@@ -285,6 +296,54 @@ func (self Result[T]) OrElse(op func(error) Result[T]) Result[T] {
 	}
 }
 
+// Wraps the Err value with a context message, leaving an Ok value untouched.
+// The wrapped error is a ContextError, so the original error can still be found with errors.Is and errors.As.
+//
+//	x := gost.Err[gost.I32](io.EOF).Context("failed to read config")
+//	gost.Assert(errors.Is(x.UnwrapErr(), io.EOF))
+//	gost.AssertEq(gost.String(x.UnwrapErr().Error()), gost.String("failed to read config: EOF"))
+func (self Result[T]) Context(context String) Result[T] {
+	if self.IsOk() {
+		return self
+	}
+
+	backtrace := self.backtrace
+	if backtrace.IsNone() {
+		backtrace = _ErrorBacktrace(self.err)
+	}
+
+	return Result[T]{err: ContextError{context: context, source: self.err, backtrace: backtrace}, backtrace: backtrace}
+}
+
+// Wraps the Err value with a context message that is evaluated lazily, only if the result is Err.
+//
+//	x := gost.Err[gost.I32](io.EOF).WithContext(func() gost.String { return gost.Format("failed to read {}", path) })
+//	gost.Assert(errors.Is(x.UnwrapErr(), io.EOF))
+func (self Result[T]) WithContext(f func() String) Result[T] {
+	if self.IsOk() {
+		return self
+	}
+
+	return self.Context(f())
+}
+
+// Returns the Backtrace captured when the Err was constructed, if backtraces were enabled.
+//
+//	gost.SetBacktraceEnabled(true)
+//	x := gost.Err[gost.I32](io.EOF)
+//	gost.Assert(x.Backtrace().IsSome())
+func (self Result[T]) Backtrace() Option[Backtrace] {
+	if self.IsOk() {
+		return None[Backtrace]()
+	}
+
+	if self.backtrace.IsSome() {
+		return self.backtrace
+	}
+
+	return _ErrorBacktrace(self.err)
+}
+
 // Returns the contained Ok value or a provided default.
 // Arguments passed to unwrap_or are eagerly evaluated; if you are passing the result of a function call, it is recommended to use unwrap_or_else, which is lazily evaluated.
 //
@@ -316,6 +375,97 @@ func (self Result[T]) UnwrapOrElse(f func() T) T {
 	}
 }
 
+// Maps a Result<T, E> to Result<U, E> by applying a function to a contained Ok value, leaving an Err value untouched.
+//
+//	x := gost.Ok[gost.I32](gost.I32(2))
+//	gost.AssertEq(gost.ResultMap(x, func(value gost.I32) gost.String { return value.ToString() }), gost.Ok[gost.String]("2"))
+func ResultMap[T any, U any](result Result[T], f func(T) U) Result[U] {
+	if result.IsOk() {
+		return Ok[U](f(*result.ok))
+	} else {
+		return _ErrFrom[U](result)
+	}
+}
+
+// Returns the provided default (if Err), or applies a function to the contained value (if Ok).
+//
+//	y := gost.Err[gost.I32](errors.New("error"))
+//	gost.AssertEq(gost.ResultMapOr(y, gost.String("error"), func(value gost.I32) gost.String { return value.ToString() }), gost.String("error"))
+func ResultMapOr[T any, U any](result Result[T], defaultValue U, f func(T) U) U {
+	if result.IsOk() {
+		return f(*result.ok)
+	} else {
+		return defaultValue
+	}
+}
+
+// Maps a Result<T, E> to U by applying fallback function default to a contained Err value, or function f to a contained Ok value.
+//
+//	x := gost.Ok[gost.I32](gost.I32(2))
+//	gost.AssertEq(gost.ResultMapOrElse(x, func(err error) gost.String { return gost.String(err.Error()) }, func(value gost.I32) gost.String { return value.ToString() }), gost.String("2"))
+func ResultMapOrElse[T any, U any](result Result[T], defaultValue func(error) U, f func(T) U) U {
+	if result.IsOk() {
+		return f(*result.ok)
+	} else {
+		return defaultValue(result.err)
+	}
+}
+
+// Returns res if the result is Ok, otherwise returns the Err value of result.
+//
+//	x := gost.Ok[gost.I32](gost.I32(2))
+//	gost.AssertEq(gost.ResultAnd(x, gost.Ok[gost.String]("a")), gost.Ok[gost.String]("a"))
+func ResultAnd[T any, U any](result Result[T], res Result[U]) Result[U] {
+	if result.IsOk() {
+		return res
+	} else {
+		return _ErrFrom[U](result)
+	}
+}
+
+// Calls op if the result is Ok, otherwise returns the Err value of result.
+// This function can be used for control flow based on Result values.
+//
+//	x := gost.Ok[gost.String]("2")
+//	gost.AssertEq(gost.ResultAndThen(x, func(value gost.String) gost.Result[gost.I64] { return value.ParseI64() }), gost.Ok[gost.I64](2))
+func ResultAndThen[T any, U any](result Result[T], op func(T) Result[U]) Result[U] {
+	if result.IsOk() {
+		return op(*result.ok)
+	} else {
+		return _ErrFrom[U](result)
+	}
+}
+
+// Converts from Result<Result<T, E>, E> to Result<T, E>.
+//
+//	x := gost.Ok(gost.Ok[gost.I32](gost.I32(2)))
+//	gost.AssertEq(gost.ResultFlatten(x), gost.Ok[gost.I32](gost.I32(2)))
+func ResultFlatten[T any](result Result[Result[T]]) Result[T] {
+	if result.IsOk() {
+		return *result.ok
+	} else {
+		return _ErrFrom[T](result)
+	}
+}
+
+// Transposes a Result of an Option into an Option of a Result.
+// Ok(None) will be mapped to None. Ok(Some(_)) and Err(_) will be mapped to Some(Ok(_)) and Some(Err(_)).
+//
+//	x := gost.Ok(gost.Some[gost.I32](5))
+//	gost.AssertEq(gost.ResultTranspose(x), gost.Some(gost.Ok[gost.I32](5)))
+func ResultTranspose[T any](result Result[Option[T]]) Option[Result[T]] {
+	if result.IsErr() {
+		return Some(_ErrFrom[T](result))
+	}
+
+	option := *result.ok
+	if option.IsNone() {
+		return None[Result[T]]()
+	} else {
+		return Some(Ok[T](*option.value))
+	}
+}
+
 // impl Display for Result
 func (self Result[T]) Display() String {
 	if self.IsOk() {
@@ -334,8 +484,19 @@ func (self Result[T]) Display() String {
 }
 
 // impl Debug for Result
+// An Err is printed with its full cause chain, followed by its backtrace if one was captured.
 func (self Result[T]) Debug() String {
-	return self.Display()
+	if self.IsOk() {
+		return self.Display()
+	}
+
+	report := ErrorReport(self.err)
+
+	if self.backtrace.IsSome() && _ErrorBacktrace(self.err).IsNone() {
+		report += "\n\nStack backtrace:\n" + self.backtrace.Unwrap().Display()
+	}
+
+	return report
 }
 
 // impl AsRef for Result
@@ -348,7 +509,7 @@ func (self Result[T]) Clone() Result[T] {
 	if self.IsOk() {
 		return Ok[T](castToClone[T](*self.ok).Unwrap().Clone())
 	} else {
-		return Err[T](self.err)
+		return Result[T]{err: self.err, backtrace: self.backtrace}
 	}
 }
 
@@ -0,0 +1,33 @@
+package gost
+
+import (
+	"errors"
+	"testing"
+)
+
+func Test_ResultMap(t *testing.T) {
+	t.Parallel()
+
+	err := errors.New("error")
+
+	mapped := ResultMap(Ok[I32](2), func(value I32) String { return value.ToString() })
+	AssertEq(mapped, Ok[String]("2"), "ResultMap")
+	parsed := ResultAndThen(Ok[String]("x"), func(value String) Result[I64] { return value.ParseI64() })
+	Assert(parsed.IsErr(), "ResultAndThen Err")
+	Assert(ResultAnd(Err[I32](err), Ok[String]("a")).UnwrapErr() == err, "ResultAnd keeps the first Err")
+}
+
+func Test_ResultFlatten(t *testing.T) {
+	t.Parallel()
+
+	AssertEq(ResultFlatten(Ok(Ok[I32](2))), Ok[I32](2), "ResultFlatten")
+	Assert(ResultFlatten(Ok(Err[I32](errors.New("inner")))).IsErr(), "ResultFlatten inner Err")
+}
+
+func Test_ResultTranspose(t *testing.T) {
+	t.Parallel()
+
+	AssertEq(ResultTranspose(Ok(Some[I32](5))).Unwrap().Unwrap(), I32(5), "ResultTranspose Ok(Some)")
+	Assert(ResultTranspose(Ok(None[I32]())).IsNone(), "ResultTranspose Ok(None)")
+	Assert(ResultTranspose(Err[Option[I32]](errors.New("error"))).Unwrap().IsErr(), "ResultTranspose Err")
+}
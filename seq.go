@@ -0,0 +1,200 @@
+//go:build go1.23
+
+package gost
+
+import "iter"
+
+// Converts an Iterator into an iter.Seq, so it can be driven by a range-over-func loop.
+// The Iterator is consumed lazily; breaking out of the loop stops pulling elements.
+//
+//	vec := gost.VecNew[gost.I32]()
+//	vec.Push(1)
+//	vec.Push(2)
+//	for value := range gost.Seq(vec.IntoIter()) {
+//		gost.Println("{}", value)
+//	}
+func Seq[T any](iterator Iterator[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for {
+			value := iterator.Next()
+
+			if value.IsNone() {
+				return
+			}
+
+			if !yield(value.Unwrap()) {
+				return
+			}
+		}
+	}
+}
+
+// Converts an Iterator of Pair into an iter.Seq2, yielding Key and Value as the two loop variables.
+//
+//	hashMap := gost.HashMapNew[gost.String, gost.I32]()
+//	hashMap.Insert("a", 1)
+//	for key, value := range gost.Seq2(hashMap.IntoIter()) {
+//		gost.Println("{}: {}", key, value)
+//	}
+func Seq2[K any, V any](iterator Iterator[Pair[K, V]]) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for {
+			value := iterator.Next()
+
+			if value.IsNone() {
+				return
+			}
+
+			pair := value.Unwrap()
+			if !yield(pair.Key, pair.Value) {
+				return
+			}
+		}
+	}
+}
+
+// An iterator that pulls its values from an iter.Seq.
+// This is created by IterFromSeq and IterFromSeq2.
+type SeqIter[T any] struct {
+	_IterBase[T]
+	next func() (T, bool)
+	stop func()
+}
+
+// Creates an Iterator that pulls its values from an iter.Seq.
+// The sequence runs lazily, one value per call to Next.
+// If the Iterator is dropped before it is exhausted, call Stop to release the sequence.
+//
+//	iter := gost.IterFromSeq(slices.Values([]gost.I32{1, 2, 3}))
+//	defer iter.Stop()
+//	gost.AssertEq(iter.Next(), gost.Some[gost.I32](1))
+func IterFromSeq[T any](seq iter.Seq[T]) *SeqIter[T] {
+	next, stop := iter.Pull(seq)
+
+	iterator := &SeqIter[T]{next: next, stop: stop}
+	iterator._IterBase = _IterBase[T]{iterator}
+	return iterator
+}
+
+// Creates an Iterator of Pair that pulls its values from an iter.Seq2.
+// If the Iterator is dropped before it is exhausted, call Stop to release the sequence.
+//
+//	iter := gost.IterFromSeq2(maps.All(map[gost.String]gost.I32{"a": 1}))
+//	defer iter.Stop()
+//	gost.AssertEq(iter.Next().Unwrap().Key, gost.String("a"))
+func IterFromSeq2[K any, V any](seq iter.Seq2[K, V]) *SeqIter[Pair[K, V]] {
+	next, stop := iter.Pull2(seq)
+
+	iterator := &SeqIter[Pair[K, V]]{
+		next: func() (Pair[K, V], bool) {
+			key, value, ok := next()
+			return Pair[K, V]{Key: key, Value: value}, ok
+		},
+		stop: stop,
+	}
+	iterator._IterBase = _IterBase[Pair[K, V]]{iterator}
+	return iterator
+}
+
+// next
+func (self *SeqIter[T]) Next() Option[T] {
+	value, ok := self.next()
+
+	if !ok {
+		return None[T]()
+	}
+
+	return Some[T](value)
+}
+
+// Stops the underlying sequence. Subsequent calls to Next return None.
+// It is safe to call Stop more than once, and it is not needed once Next has returned None.
+func (self *SeqIter[T]) Stop() {
+	self.stop()
+}
+
+// Creates a Vec from the values of an iter.Seq.
+//
+//	vec := gost.VecFromSeq(slices.Values([]gost.I32{1, 2, 3}))
+//	gost.AssertEq(vec.Len(), gost.USize(3))
+func VecFromSeq[T any](seq iter.Seq[T]) Vec[T] {
+	vec := VecNew[T]()
+
+	for value := range seq {
+		vec.Push(value)
+	}
+
+	return vec
+}
+
+// Creates a VecDeque from the values of an iter.Seq.
+//
+//	deque := gost.VecDequeFromSeq(slices.Values([]gost.I32{1, 2, 3}))
+//	gost.AssertEq(deque.Len(), gost.USize(3))
+func VecDequeFromSeq[T any](seq iter.Seq[T]) VecDeque[T] {
+	deque := VecDequeNew[T]()
+
+	for value := range seq {
+		deque.PushBack(value)
+	}
+
+	return deque
+}
+
+// Creates a HashSet from the values of an iter.Seq.
+//
+//	set := gost.HashSetFromSeq(slices.Values([]gost.I32{1, 2, 2}))
+//	gost.AssertEq(set.Len(), gost.USize(2))
+func HashSetFromSeq[K comparable](seq iter.Seq[K]) HashSet[K] {
+	set := HashSetNew[K]()
+
+	for value := range seq {
+		set.Insert(value)
+	}
+
+	return set
+}
+
+// Creates a BTreeSet from the values of an iter.Seq.
+//
+//	set := gost.BTreeSetFromSeq(slices.Values([]gost.I32{2, 1, 2}))
+//	gost.AssertEq(set.Len(), gost.USize(2))
+func BTreeSetFromSeq[K Ord[K]](seq iter.Seq[K]) BTreeSet[K] {
+	set := BTreeSetNew[K]()
+
+	for value := range seq {
+		set.Insert(value)
+	}
+
+	return set
+}
+
+// Creates a HashMap from the key-value pairs of an iter.Seq2.
+// If a key is yielded more than once, the last value wins.
+//
+//	hashMap := gost.HashMapFromSeq2(maps.All(map[gost.String]gost.I32{"a": 1}))
+//	gost.AssertEq(hashMap.Get("a"), gost.Some[gost.I32](1))
+func HashMapFromSeq2[K comparable, V any](seq iter.Seq2[K, V]) HashMap[K, V] {
+	hashMap := HashMapNew[K, V]()
+
+	for key, value := range seq {
+		hashMap.Insert(key, value)
+	}
+
+	return hashMap
+}
+
+// Creates a BTreeMap from the key-value pairs of an iter.Seq2.
+// If a key is yielded more than once, the last value wins.
+//
+//	treeMap := gost.BTreeMapFromSeq2(maps.All(map[gost.String]gost.I32{"a": 1}))
+//	gost.AssertEq(treeMap.Get("a"), gost.Some[gost.I32](1))
+func BTreeMapFromSeq2[K Ord[K], V any](seq iter.Seq2[K, V]) BTreeMap[K, V] {
+	treeMap := BTreeMapNew[K, V]()
+
+	for key, value := range seq {
+		treeMap.Insert(key, value)
+	}
+
+	return treeMap
+}
@@ -0,0 +1,78 @@
+//go:build go1.23
+
+package gost
+
+import (
+	"maps"
+	"slices"
+	"testing"
+)
+
+func Test_Seq(t *testing.T) {
+	t.Parallel()
+
+	vec := VecNew[I32]()
+	vec.Push(1)
+	vec.Push(2)
+	vec.Push(3)
+
+	sum := I32(0)
+	for value := range Seq(vec.IntoIter()) {
+		sum += value
+	}
+	AssertEq(sum, I32(6), "range over Seq")
+
+	iter := vec.IntoIter()
+	for value := range Seq(iter) {
+		if value == 2 {
+			break
+		}
+	}
+	AssertEq(iter.Next(), Some[I32](3), "break stops pulling from the Iterator")
+
+	treeMap := BTreeMapNew[String, I32]()
+	treeMap.Insert("a", 1)
+	treeMap.Insert("b", 2)
+
+	keys := VecNew[String]()
+	for key, value := range Seq2(treeMap.IntoIter()) {
+		keys.Push(key)
+		AssertEq(treeMap.Get(key), Some[I32](value), "range over Seq2 value")
+	}
+	AssertEq(keys.Len(), USize(2), "range over Seq2 length")
+	AssertEq(keys.GetUnchecked(0), String("a"), "range over Seq2 order")
+}
+
+func Test_IterFromSeq(t *testing.T) {
+	t.Parallel()
+
+	iter := IterFromSeq(slices.Values([]I32{1, 2, 3}))
+	AssertEq(iter.Map(func(e I32) I32 { return e * 2 }).Sum(), I32(12), "IterFromSeq adapters")
+	Assert(iter.Next().IsNone(), "IterFromSeq exhausted")
+
+	partial := IterFromSeq(slices.Values([]I32{1, 2, 3}))
+	AssertEq(partial.Next(), Some[I32](1), "IterFromSeq first")
+	partial.Stop()
+	Assert(partial.Next().IsNone(), "IterFromSeq after Stop")
+
+	pairs := IterFromSeq2(maps.All(map[String]I32{"a": 1}))
+	defer pairs.Stop()
+	AssertEq(pairs.Next().Unwrap().Value, I32(1), "IterFromSeq2")
+}
+
+func Test_CollectFromSeq(t *testing.T) {
+	t.Parallel()
+
+	values := []I32{3, 1, 3, 2}
+
+	AssertEq(VecFromSeq(slices.Values(values)).Len(), USize(4), "VecFromSeq")
+	AssertEq(VecDequeFromSeq(slices.Values(values)).Back(), Some[I32](2), "VecDequeFromSeq")
+	AssertEq(HashSetFromSeq(slices.Values(values)).Len(), USize(3), "HashSetFromSeq")
+	treeSet := BTreeSetFromSeq(slices.Values(values))
+	AssertEq(treeSet.IntoIter().Next(), Some[I32](1), "BTreeSetFromSeq")
+
+	raw := map[String]I32{"a": 1, "b": 2}
+	AssertEq(HashMapFromSeq2(maps.All(raw)).Get("b"), Some[I32](2), "HashMapFromSeq2")
+	treeMap := BTreeMapFromSeq2(maps.All(raw))
+	AssertEq(treeMap.Len(), USize(2), "BTreeMapFromSeq2")
+}
@@ -170,3 +170,32 @@ func (self String) ToUppercase() String {
 func (self String) Repeat(n USize) String {
 	return String(strings.Repeat(string(self), int(n)))
 }
+
+// Extends a String with the chars of an iterator.
+//
+//	str := gost.String("ab")
+//	str.Extend(gost.String("cd").Chars())
+//	gost.AssertEq(str, gost.String("abcd"))
+func (self *String) Extend(iter IntoIterator[Char]) {
+	iterator := iter.IntoIter()
+	builder := strings.Builder{}
+	builder.WriteString(string(*self))
+
+	for {
+		value := iterator.Next()
+
+		if value.IsNone() {
+			break
+		}
+
+		builder.WriteRune(rune(value.Unwrap()))
+	}
+
+	*self = String(builder.String())
+}
+
+// impl FromIterator for String
+func (self *String) FromIter(iter Iterator[Char]) {
+	*self = ""
+	self.Extend(iter)
+}
@@ -1,18 +1,26 @@
 package gost
 
-import "sync"
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
 
 // A mutual exclusion lock.
+// The value can only be reached through the MutexGuard returned by Lock, or inside WithLock.
+// A Mutex must not be copied after first use.
 //
-//  locker := MutexNew[ISize](0)
-//  ptr := locker.Lock()
-//  *ptr = 1
-//  locker.Unlock()
+//	locker := gost.MutexNew[gost.ISize](0)
+//	guard := locker.Lock().Unwrap()
+//	guard.Set(1)
+//	guard.Drop()
 type Mutex[T any] struct {
-	value *T
-	lock  sync.Mutex
+	value    *T
+	lock     sync.Mutex
+	poisoned atomic.Bool
 }
 
+// Creates a new mutex in an unlocked state ready for use.
 func MutexNew[T any](value T) Mutex[T] {
 	return Mutex[T]{
 		value: &value,
@@ -20,24 +28,51 @@ func MutexNew[T any](value T) Mutex[T] {
 	}
 }
 
-// Acquires a mutex, blocking the current thread until it is able to do so.
+// The result of locking a Mutex. It is Ok with the guard, or an Err of PoisonError, which also carries the guard, if the mutex is poisoned.
+type LockResult[G any] struct {
+	Result[G]
+}
+
+// An error returned when a lock is acquired, but the lock is poisoned because a previous holder panicked while holding it.
+// The guard is still held, and can be recovered with IntoInner to use the value anyway.
 //
-//  locker := MutexNew[ISize](0)
-//  ptr := locker.Lock()
-//  *ptr = 1
-func (self *Mutex[T]) Lock() *T {
-	self.lock.Lock()
-	return self.value
+//	result := locker.Lock()
+//	if result.IsErr() {
+//		guard := result.UnwrapErr().(gost.PoisonError[gost.MutexGuard[gost.ISize]]).IntoInner()
+//		guard.Drop()
+//	}
+type PoisonError[G any] struct {
+	guard G
+}
+
+// Consumes the error, returning the guard it carries.
+func (self PoisonError[G]) IntoInner() G {
+	return self.guard
+}
+
+// impl error for PoisonError
+func (self PoisonError[G]) Error() string {
+	return "poisoned lock: another task failed inside"
+}
+
+func _LockResultFrom[G any](guard G, poisoned bool) LockResult[G] {
+	if poisoned {
+		return LockResult[G]{Err[G](PoisonError[G]{guard: guard})}
+	} else {
+		return LockResult[G]{Ok(guard)}
+	}
 }
 
-// Immediately drops the guard, and consequently unlocks the mutex.
+// Acquires a mutex, blocking the current thread until it is able to do so.
+// Returns an Err of PoisonError, which still holds the lock, if the mutex is poisoned.
 //
-//  locker := MutexNew[ISize](0)
-//  ptr := locker.Lock()
-//  *ptr = 1
-//  locker.Unlock()
-func (self *Mutex[T]) Unlock() {
-	self.lock.Unlock()
+//	locker := gost.MutexNew[gost.ISize](0)
+//	guard := locker.Lock().Unwrap()
+//	defer guard.Drop()
+//	guard.Set(guard.Get() + 1)
+func (self *Mutex[T]) Lock() LockResult[MutexGuard[T]] {
+	self.lock.Lock()
+	return _LockResultFrom(MutexGuard[T]{mutex: self, released: &atomic.Bool{}}, self.poisoned.Load())
 }
 
 // An error returned by TryLock.
@@ -48,18 +83,625 @@ func (self TryLockError) Error() string {
 }
 
 // Attempts to acquire this lock.
-// If the lock could not be acquired at this time, then Err is returned. Otherwise, an RAII guard is returned. The lock will be unlocked when the guard is dropped.
+// If the lock could not be acquired at this time, then an Err of TryLockError is returned. If it was acquired but the mutex is poisoned, an Err of PoisonError is returned. Otherwise, a guard is returned. The lock will be unlocked when the guard is dropped.
 //
-//  locker := MutexNew[ISize](0)
-//  ptr := locker.TryLock()
-//  if ptr.IsErr() {
-//      panic("Mutex is locked")
-//  }
-//  *ptr.Unwrap() = 1
-func (self *Mutex[T]) TryLock() Result[*T] {
+//	locker := gost.MutexNew[gost.ISize](0)
+//	guard := locker.TryLock()
+//	if guard.IsErr() {
+//		panic("Mutex is locked")
+//	}
+//	guard.Unwrap().Set(1)
+func (self *Mutex[T]) TryLock() Result[MutexGuard[T]] {
 	if self.lock.TryLock() {
-		return Ok[*T](self.value)
+		return _LockResultFrom(MutexGuard[T]{mutex: self, released: &atomic.Bool{}}, self.poisoned.Load()).Result
+	} else {
+		return Err[MutexGuard[T]](TryLockError{})
+	}
+}
+
+// Acquires the mutex, calls f with a pointer to the value, and unlocks the mutex when f returns, even if f panics.
+// If f panics, the mutex is poisoned and the panic is propagated. The pointer must not be kept after f returns.
+// Returns an Err of PoisonError[MutexGuard[T]], without calling f, if the mutex is already poisoned. The guard in the error still holds the lock, so the value can be checked or restored before dropping it.
+//
+//	locker := gost.MutexNew(gost.VecNew[gost.I32]())
+//	locker.WithLock(func(vec *gost.Vec[gost.I32]) {
+//		vec.Push(1)
+//	})
+func (self *Mutex[T]) WithLock(f func(value *T)) Result[any] {
+	self.lock.Lock()
+
+	if self.poisoned.Load() {
+		return Err[any](PoisonError[MutexGuard[T]]{guard: MutexGuard[T]{mutex: self, released: &atomic.Bool{}}})
+	}
+
+	defer self.lock.Unlock()
+
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			self.poisoned.Store(true)
+			panic(recovered)
+		}
+	}()
+
+	f(self.value)
+
+	return Ok[any](nil)
+}
+
+// Checks if the mutex is poisoned.
+func (self *Mutex[T]) IsPoisoned() Bool {
+	return Bool(self.poisoned.Load())
+}
+
+// Clears the poisoned state of the mutex, after the value has been checked or restored.
+func (self *Mutex[T]) ClearPoison() {
+	self.poisoned.Store(false)
+}
+
+// Consumes the mutex, returning the value. The mutex must not be used afterwards.
+// Returns an Err of PoisonError, which carries the value, if the mutex is poisoned.
+func (self *Mutex[T]) IntoInner() LockResult[T] {
+	return _LockResultFrom(*self.value, self.poisoned.Load())
+}
+
+// Returns a pointer to the value without locking, for when nothing else can access the mutex, such as before it is shared.
+// Returns an Err of PoisonError, which carries the pointer, if the mutex is poisoned.
+func (self *Mutex[T]) GetMut() LockResult[*T] {
+	return _LockResultFrom(self.value, self.poisoned.Load())
+}
+
+// Access to the value of a locked Mutex. The mutex is unlocked by Drop, after which the guard cannot be used.
+type MutexGuard[T any] struct {
+	mutex    *Mutex[T]
+	released *atomic.Bool
+}
+
+func (self MutexGuard[T]) checkHeld() {
+	if self.released.Load() {
+		panic("MutexGuard used after it was dropped")
+	}
+}
+
+// Returns a copy of the value.
+func (self MutexGuard[T]) Get() T {
+	self.checkHeld()
+	return *self.mutex.value
+}
+
+// Replaces the value.
+func (self MutexGuard[T]) Set(value T) {
+	self.checkHeld()
+	*self.mutex.value = value
+}
+
+// Returns a pointer to the value, to modify it in place. The pointer must not be used after the guard is dropped.
+func (self MutexGuard[T]) AsMut() *T {
+	self.checkHeld()
+	return self.mutex.value
+}
+
+// Immediately drops the guard, and consequently unlocks the mutex. Dropping twice does nothing.
+//
+//	locker := gost.MutexNew[gost.ISize](0)
+//	guard := locker.Lock().Unwrap()
+//	guard.Set(1)
+//	guard.Drop()
+func (self MutexGuard[T]) Drop() {
+	if self.released.CompareAndSwap(false, true) {
+		self.mutex.lock.Unlock()
+	}
+}
+
+// A reader-writer lock. Any number of readers can hold the lock at once, or a single writer.
+// The value can only be reached through the guards returned by Read and Write, or inside WithWrite.
+// A RwLock must not be copied after first use.
+//
+//	config := gost.RwLockNew(gost.String("v1"))
+//	reader := config.Read().Unwrap()
+//	gost.AssertEq(reader.Get(), gost.String("v1"))
+//	reader.Drop()
+type RwLock[T any] struct {
+	value    *T
+	lock     sync.RWMutex
+	poisoned atomic.Bool
+}
+
+// Creates a new reader-writer lock in an unlocked state ready for use.
+func RwLockNew[T any](value T) RwLock[T] {
+	return RwLock[T]{
+		value: &value,
+		lock:  sync.RWMutex{},
+	}
+}
+
+// Acquires shared read access, blocking the current thread until no writer holds the lock.
+// Returns an Err of PoisonError, which still holds the lock, if the lock is poisoned.
+func (self *RwLock[T]) Read() LockResult[RwLockReadGuard[T]] {
+	self.lock.RLock()
+	return _LockResultFrom(RwLockReadGuard[T]{rwlock: self, released: &atomic.Bool{}}, self.poisoned.Load())
+}
+
+// Acquires exclusive write access, blocking the current thread until no one else holds the lock.
+// Returns an Err of PoisonError, which still holds the lock, if the lock is poisoned.
+func (self *RwLock[T]) Write() LockResult[RwLockWriteGuard[T]] {
+	self.lock.Lock()
+	return _LockResultFrom(RwLockWriteGuard[T]{rwlock: self, released: &atomic.Bool{}}, self.poisoned.Load())
+}
+
+// Attempts to acquire shared read access.
+// Returns an Err of TryLockError if a writer holds the lock, or an Err of PoisonError if the lock is poisoned.
+func (self *RwLock[T]) TryRead() Result[RwLockReadGuard[T]] {
+	if self.lock.TryRLock() {
+		return _LockResultFrom(RwLockReadGuard[T]{rwlock: self, released: &atomic.Bool{}}, self.poisoned.Load()).Result
+	} else {
+		return Err[RwLockReadGuard[T]](TryLockError{})
+	}
+}
+
+// Attempts to acquire exclusive write access.
+// Returns an Err of TryLockError if anyone holds the lock, or an Err of PoisonError if the lock is poisoned.
+func (self *RwLock[T]) TryWrite() Result[RwLockWriteGuard[T]] {
+	if self.lock.TryLock() {
+		return _LockResultFrom(RwLockWriteGuard[T]{rwlock: self, released: &atomic.Bool{}}, self.poisoned.Load()).Result
+	} else {
+		return Err[RwLockWriteGuard[T]](TryLockError{})
+	}
+}
+
+// Acquires write access, calls f with a pointer to the value, and releases the lock when f returns, even if f panics.
+// If f panics, the lock is poisoned and the panic is propagated. The pointer must not be kept after f returns.
+// Returns an Err of PoisonError[RwLockWriteGuard[T]], without calling f, if the lock is already poisoned. The guard in the error still holds write access, so the value can be checked or restored before dropping it.
+func (self *RwLock[T]) WithWrite(f func(value *T)) Result[any] {
+	self.lock.Lock()
+
+	if self.poisoned.Load() {
+		return Err[any](PoisonError[RwLockWriteGuard[T]]{guard: RwLockWriteGuard[T]{rwlock: self, released: &atomic.Bool{}}})
+	}
+
+	defer self.lock.Unlock()
+
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			self.poisoned.Store(true)
+			panic(recovered)
+		}
+	}()
+
+	f(self.value)
+
+	return Ok[any](nil)
+}
+
+// Checks if the lock is poisoned.
+func (self *RwLock[T]) IsPoisoned() Bool {
+	return Bool(self.poisoned.Load())
+}
+
+// Clears the poisoned state of the lock, after the value has been checked or restored.
+func (self *RwLock[T]) ClearPoison() {
+	self.poisoned.Store(false)
+}
+
+// Consumes the lock, returning the value. The lock must not be used afterwards.
+// Returns an Err of PoisonError, which carries the value, if the lock is poisoned.
+func (self *RwLock[T]) IntoInner() LockResult[T] {
+	return _LockResultFrom(*self.value, self.poisoned.Load())
+}
+
+// Returns a pointer to the value without locking, for when nothing else can access the lock, such as before it is shared.
+// Returns an Err of PoisonError, which carries the pointer, if the lock is poisoned.
+func (self *RwLock[T]) GetMut() LockResult[*T] {
+	return _LockResultFrom(self.value, self.poisoned.Load())
+}
+
+// Shared read access to the value of an RwLock. The lock is released by Drop, after which the guard cannot be used.
+type RwLockReadGuard[T any] struct {
+	rwlock   *RwLock[T]
+	released *atomic.Bool
+}
+
+// Returns a copy of the value.
+func (self RwLockReadGuard[T]) Get() T {
+	if self.released.Load() {
+		panic("RwLockReadGuard used after it was dropped")
+	}
+
+	return *self.rwlock.value
+}
+
+// Immediately drops the guard, and consequently releases read access. Dropping twice does nothing.
+func (self RwLockReadGuard[T]) Drop() {
+	if self.released.CompareAndSwap(false, true) {
+		self.rwlock.lock.RUnlock()
+	}
+}
+
+// Exclusive write access to the value of an RwLock. The lock is released by Drop, after which the guard cannot be used.
+type RwLockWriteGuard[T any] struct {
+	rwlock   *RwLock[T]
+	released *atomic.Bool
+}
+
+func (self RwLockWriteGuard[T]) checkHeld() {
+	if self.released.Load() {
+		panic("RwLockWriteGuard used after it was dropped")
+	}
+}
+
+// Returns a copy of the value.
+func (self RwLockWriteGuard[T]) Get() T {
+	self.checkHeld()
+	return *self.rwlock.value
+}
+
+// Replaces the value.
+func (self RwLockWriteGuard[T]) Set(value T) {
+	self.checkHeld()
+	*self.rwlock.value = value
+}
+
+// Returns a pointer to the value, to modify it in place. The pointer must not be used after the guard is dropped.
+func (self RwLockWriteGuard[T]) AsMut() *T {
+	self.checkHeld()
+	return self.rwlock.value
+}
+
+// Immediately drops the guard, and consequently releases write access. Dropping twice does nothing.
+func (self RwLockWriteGuard[T]) Drop() {
+	if self.released.CompareAndSwap(false, true) {
+		self.rwlock.lock.Unlock()
+	}
+}
+
+// A synchronization primitive which can be used to run a one-time global initialization.
+// A Once must not be copied after first use.
+//
+//	var INIT gost.Once
+//	INIT.CallOnce(func() {
+//		// ...
+//	})
+type Once struct {
+	lock     sync.Mutex
+	done     atomic.Bool
+	poisoned bool
+}
+
+// Creates a new Once, which has not run yet.
+func OnceNew() Once {
+	return Once{}
+}
+
+// Runs f if this is the first call to CallOnce that completes, blocking other callers until it has run.
+// If f panics, the Once is poisoned, the panic is propagated, and every later call panics too.
+func (self *Once) CallOnce(f func()) {
+	if self.done.Load() {
+		return
+	}
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if self.done.Load() {
+		return
+	}
+
+	if self.poisoned {
+		panic("Once instance has previously been poisoned")
+	}
+
+	self.poisoned = true
+	f()
+	self.poisoned = false
+	self.done.Store(true)
+}
+
+// Checks if a call to CallOnce has completed.
+func (self *Once) IsCompleted() Bool {
+	return Bool(self.done.Load())
+}
+
+// The error returned by OnceLock.Set when the cell is already initialized. It carries the value that was not stored.
+type OnceLockSetError[T any] struct {
+	value T
+}
+
+// Returns the value that was not stored.
+func (self OnceLockSetError[T]) Value() T {
+	return self.value
+}
+
+// impl error for OnceLockSetError
+func (self OnceLockSetError[T]) Error() string {
+	return "OnceLock is already initialized"
+}
+
+// A cell which can be written to only once, and then read by any number of threads.
+// A OnceLock must not be copied after first use.
+//
+//	var CONFIG gost.OnceLock[gost.String]
+//	config := CONFIG.GetOrInit(func() gost.String { return "loaded" })
+type OnceLock[T any] struct {
+	lock sync.Mutex
+	// Set once the cell is initialized. The value it points to is never changed afterwards, so it can be read without the lock; Take swaps in nil instead.
+	value atomic.Pointer[T]
+}
+
+// Creates a new, uninitialized cell.
+func OnceLockNew[T any]() OnceLock[T] {
+	return OnceLock[T]{}
+}
+
+// Returns the value, or None if the cell is not initialized yet.
+func (self *OnceLock[T]) Get() Option[T] {
+	if value := self.value.Load(); value != nil {
+		return Some(*value)
+	}
+
+	return None[T]()
+}
+
+// Initializes the cell with value, if it is not initialized yet.
+// Returns an Err of OnceLockSetError, carrying the value back, if it was already initialized.
+func (self *OnceLock[T]) Set(value T) Result[any] {
+	stored := false
+
+	self.GetOrInit(func() T {
+		stored = true
+		return value
+	})
+
+	if stored {
+		return Ok[any](nil)
 	} else {
-		return Err[*T](TryLockError{})
+		return Err[any](OnceLockSetError[T]{value: value})
+	}
+}
+
+// Returns the value, initializing the cell with f if it is not initialized yet. Only one f runs, and other callers block until it returns.
+// If f panics, the panic is propagated and the cell stays uninitialized.
+func (self *OnceLock[T]) GetOrInit(f func() T) T {
+	if value := self.value.Load(); value != nil {
+		return *value
+	}
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if value := self.value.Load(); value != nil {
+		return *value
+	}
+
+	value := f()
+	self.value.Store(&value)
+
+	return value
+}
+
+// Consumes the cell, returning the value, or None if it was not initialized. The cell must not be used afterwards.
+func (self *OnceLock[T]) IntoInner() Option[T] {
+	return self.Get()
+}
+
+// Takes the value out of the cell, leaving it uninitialized. It is safe to call while other threads use the cell.
+func (self *OnceLock[T]) Take() Option[T] {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if value := self.value.Swap(nil); value != nil {
+		return Some(*value)
 	}
+
+	return None[T]()
+}
+
+// A value which is computed on first access, for lazily initialized globals.
+// A LazyLock must not be copied after first use.
+//
+//	var ENV = gost.LazyLockNew(func() gost.String { return gost.String(os.Getenv("APP_ENV")) })
+//	gost.Println("{}", ENV.Get())
+type LazyLock[T any] struct {
+	cell OnceLock[T]
+	init func() T
+}
+
+// Creates a new LazyLock, which computes its value with f on first access.
+func LazyLockNew[T any](f func() T) LazyLock[T] {
+	return LazyLock[T]{init: f}
+}
+
+// Returns the value, computing it first if this is the first access.
+// If the computation panics, the panic is propagated and the next access computes it again.
+func (self *LazyLock[T]) Get() T {
+	return self.cell.GetOrInit(self.init)
+}
+
+// A Condition Variable, to block a thread while waiting for the value of a Mutex[T] to change.
+// A Condvar should only be used with one mutex at a time, and must not be copied after first use.
+//
+//	ready := gost.MutexNew(false)
+//	condvar := gost.CondvarNew[bool]()
+//
+//	gost.Spawn(func() {
+//		ready.WithLock(func(value *bool) { *value = true })
+//		condvar.NotifyOne()
+//	})
+//
+//	guard := condvar.WaitWhile(ready.Lock().Unwrap(), func(value *bool) gost.Bool { return gost.Bool(!*value) }).Unwrap()
+//	guard.Drop()
+type Condvar[T any] struct {
+	lock    sync.Mutex
+	waiters []chan struct{}
+}
+
+// Creates a new Condvar, with no waiting thread.
+func CondvarNew[T any]() Condvar[T] {
+	return Condvar[T]{}
+}
+
+// The result of Condvar.WaitTimeout.
+type WaitTimeoutResult struct {
+	timedOut bool
+}
+
+// Returns true if the wait ended because the timeout elapsed, rather than a notification.
+func (self WaitTimeoutResult) TimedOut() Bool {
+	return Bool(self.timedOut)
+}
+
+func (self *Condvar[T]) register() chan struct{} {
+	ch := make(chan struct{})
+
+	self.lock.Lock()
+	self.waiters = append(self.waiters, ch)
+	self.lock.Unlock()
+
+	return ch
+}
+
+// Removes ch from the waiters, returning false if it was already notified.
+func (self *Condvar[T]) unregister(ch chan struct{}) bool {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	for i, waiter := range self.waiters {
+		if waiter == ch {
+			self.waiters = append(self.waiters[:i], self.waiters[i+1:]...)
+			return true
+		}
+	}
+
+	return false
+}
+
+// Blocks the current thread until this condition variable receives a notification.
+// The mutex of guard is unlocked while waiting, and locked again before returning; guard stays valid. Wakeups can be spurious, so check the condition again, or use WaitWhile.
+// Returns an Err of PoisonError, which still holds the lock, if the mutex is poisoned.
+func (self *Condvar[T]) Wait(guard MutexGuard[T]) LockResult[MutexGuard[T]] {
+	guard.checkHeld()
+	ch := self.register()
+
+	guard.mutex.lock.Unlock()
+	<-ch
+	guard.mutex.lock.Lock()
+
+	return _LockResultFrom(guard, guard.mutex.poisoned.Load())
+}
+
+// Blocks the current thread while condition returns true for the value of the mutex, waiting for notifications in between.
+// Returns the guard, with the mutex locked, once condition returns false, or an Err of PoisonError if the mutex is poisoned.
+func (self *Condvar[T]) WaitWhile(guard MutexGuard[T], condition func(value *T) Bool) LockResult[MutexGuard[T]] {
+	guard.checkHeld()
+
+	for condition(guard.mutex.value) {
+		result := self.Wait(guard)
+
+		if result.IsErr() {
+			return result
+		}
+	}
+
+	return _LockResultFrom(guard, guard.mutex.poisoned.Load())
+}
+
+// Blocks the current thread until this condition variable receives a notification, or until timeout has elapsed.
+// The mutex of guard is unlocked while waiting, and locked again before returning; guard stays valid.
+// Returns the guard with a WaitTimeoutResult telling whether the timeout elapsed, or an Err of PoisonError if the mutex is poisoned.
+func (self *Condvar[T]) WaitTimeout(guard MutexGuard[T], timeout Duration) LockResult[Pair[MutexGuard[T], WaitTimeoutResult]] {
+	guard.checkHeld()
+	ch := self.register()
+
+	guard.mutex.lock.Unlock()
+
+	timer := time.NewTimer(timeout.ToGoDuration())
+	timedOut := false
+
+	select {
+	case <-ch:
+	case <-timer.C:
+		// A notification that raced with the timeout still counts as one.
+		timedOut = self.unregister(ch)
+	}
+
+	timer.Stop()
+	guard.mutex.lock.Lock()
+
+	return _LockResultFrom(Pair[MutexGuard[T], WaitTimeoutResult]{Key: guard, Value: WaitTimeoutResult{timedOut: timedOut}}, guard.mutex.poisoned.Load())
+}
+
+// Wakes up the thread that has waited the longest on this condition variable, if any.
+func (self *Condvar[T]) NotifyOne() {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if len(self.waiters) > 0 {
+		close(self.waiters[0])
+		self.waiters = self.waiters[1:]
+	}
+}
+
+// Wakes up every thread waiting on this condition variable.
+func (self *Condvar[T]) NotifyAll() {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	for _, waiter := range self.waiters {
+		close(waiter)
+	}
+
+	self.waiters = nil
+}
+
+// Makes a group of threads wait until all of them have reached the barrier, then releases them together. It can be used again afterwards.
+// A Barrier must not be copied after first use.
+//
+//	barrier := gost.BarrierNew(3)
+//	for i := 0; i < 3; i++ {
+//		gost.Spawn(func() { barrier.Wait() })
+//	}
+type Barrier struct {
+	lock       sync.Mutex
+	n          USize
+	count      USize
+	generation chan struct{}
+}
+
+// Creates a barrier for n threads. A barrier for 0 threads behaves like one for 1 thread.
+func BarrierNew(n USize) Barrier {
+	if n == 0 {
+		n = 1
+	}
+
+	return Barrier{n: n, generation: make(chan struct{})}
+}
+
+// The result of Barrier.Wait.
+type BarrierWaitResult struct {
+	leader bool
+}
+
+// Returns true for exactly one thread of each group released by the barrier: the last one to arrive.
+func (self BarrierWaitResult) IsLeader() Bool {
+	return Bool(self.leader)
+}
+
+// Blocks the current thread until n threads have called Wait.
+func (self *Barrier) Wait() BarrierWaitResult {
+	self.lock.Lock()
+
+	generation := self.generation
+	self.count++
+
+	if self.count == self.n {
+		close(generation)
+		self.count = 0
+		self.generation = make(chan struct{})
+		self.lock.Unlock()
+
+		return BarrierWaitResult{leader: true}
+	}
+
+	self.lock.Unlock()
+	<-generation
+
+	return BarrierWaitResult{leader: false}
 }
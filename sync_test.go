@@ -0,0 +1,236 @@
+package gost
+
+import "testing"
+
+func Test_Mutex(t *testing.T) {
+	t.Parallel()
+
+	locker := MutexNew[I32](0)
+
+	Scope(func(scope *ThreadScope) {
+		for i := 0; i < 50; i++ {
+			scope.Spawn(func() {
+				guard := locker.Lock().Unwrap()
+				defer guard.Drop()
+				guard.Set(guard.Get() + 1)
+			})
+		}
+	})
+
+	guard := locker.Lock().Unwrap()
+	AssertEq(guard.Get(), I32(50), "Lock")
+	Assert(locker.TryLock().IsErr(), "TryLock on a locked mutex")
+	guard.Drop()
+	guard.Drop()
+
+	Assert(locker.WithLock(func(value *I32) { *value += 1 }).IsOk(), "WithLock")
+	AssertEq(*locker.GetMut().Unwrap(), I32(51), "GetMut")
+	AssertEq(locker.IntoInner().Unwrap(), I32(51), "IntoInner")
+
+	defer func() {
+		Assert(Bool(recover() != nil), "a dropped guard cannot be used")
+	}()
+	guard.Set(0)
+}
+
+func Test_Mutex_Poison(t *testing.T) {
+	t.Parallel()
+
+	locker := MutexNew(String("ok"))
+	result := CatchUnwind(func() Unit {
+		locker.WithLock(func(value *String) {
+			*value = "half"
+			panic("oh no")
+		})
+		return Unit{}
+	})
+	Assert(result.IsErr(), "WithLock propagates the panic")
+	Assert(locker.IsPoisoned(), "a panic in WithLock poisons the mutex")
+
+	called := false
+	withResult := locker.WithLock(func(value *String) { called = true })
+	Assert(withResult.IsErr(), "WithLock on a poisoned mutex")
+	Assert(Bool(!called), "WithLock does not call f on a poisoned mutex")
+	recovered := withResult.UnwrapErr().(PoisonError[MutexGuard[String]]).IntoInner()
+	AssertEq(*recovered.AsMut(), String("half"), "the guard can be recovered from WithLock")
+	recovered.Drop()
+
+	lockResult := locker.Lock()
+	Assert(lockResult.IsErr(), "Lock on a poisoned mutex")
+	guard := lockResult.UnwrapErr().(PoisonError[MutexGuard[String]]).IntoInner()
+	AssertEq(guard.Get(), String("half"), "the guard can be recovered from a PoisonError")
+	guard.Set("ok")
+	guard.Drop()
+
+	tryResult := locker.TryLock()
+	Assert(tryResult.IsErr(), "TryLock on a poisoned mutex")
+	tryResult.UnwrapErr().(PoisonError[MutexGuard[String]]).IntoInner().Drop()
+	Assert(locker.IntoInner().IsErr(), "IntoInner on a poisoned mutex")
+
+	locker.ClearPoison()
+	AssertEq(locker.Lock().Unwrap().Get(), String("ok"), "ClearPoison")
+}
+
+func Test_RwLock(t *testing.T) {
+	t.Parallel()
+
+	lock := RwLockNew(String("v1"))
+	first := lock.Read().Unwrap()
+	second := lock.TryRead().Unwrap()
+	AssertEq(first.Get()+second.Get(), String("v1v1"), "readers share the lock")
+	Assert(lock.TryWrite().IsErr(), "TryWrite while read")
+	first.Drop()
+	second.Drop()
+
+	writer := lock.Write().Unwrap()
+	Assert(lock.TryRead().IsErr(), "TryRead while written")
+	writer.Set("v2")
+	writer.Drop()
+	writer.Drop()
+
+	AssertEq(lock.TryWrite().Unwrap().Get(), String("v2"), "Write")
+}
+
+func Test_RwLock_Poison(t *testing.T) {
+	t.Parallel()
+
+	lock := RwLockNew(I32(1))
+	CatchUnwind(func() Unit {
+		lock.WithWrite(func(value *I32) {
+			*value = 2
+			panic("oh no")
+		})
+		return Unit{}
+	})
+	Assert(lock.IsPoisoned(), "a panic in WithWrite poisons the lock")
+
+	result := lock.WithWrite(func(value *I32) { *value = 3 })
+	Assert(result.IsErr(), "WithWrite on a poisoned lock")
+	guard := result.UnwrapErr().(PoisonError[RwLockWriteGuard[I32]]).IntoInner()
+	Assert(lock.TryRead().IsErr(), "the guard from WithWrite still holds the lock")
+	*guard.AsMut() = 1
+	guard.Drop()
+
+	lock.ClearPoison()
+	AssertEq(lock.Read().Unwrap().Get(), I32(1), "the value was restored through the guard")
+}
+
+func Test_Once(t *testing.T) {
+	t.Parallel()
+
+	var once Once
+	count := 0
+	Scope(func(scope *ThreadScope) {
+		for i := 0; i < 8; i++ {
+			scope.Spawn(func() { once.CallOnce(func() { count++ }) })
+		}
+	})
+	Assert(Bool(count == 1), "CallOnce runs once")
+	Assert(once.IsCompleted(), "IsCompleted")
+
+	poisoned := OnceNew()
+	Assert(CatchUnwind(func() Unit { poisoned.CallOnce(func() { panic("oh no") }); return Unit{} }).IsErr(), "CallOnce propagates the panic")
+	Assert(!poisoned.IsCompleted(), "a panicked CallOnce is not completed")
+	Assert(CatchUnwind(func() Unit { poisoned.CallOnce(func() {}); return Unit{} }).IsErr(), "a poisoned Once panics")
+}
+
+func Test_OnceLock(t *testing.T) {
+	t.Parallel()
+
+	cell := OnceLockNew[I32]()
+	AssertEq(cell.Get(), None[I32](), "Get before initialization")
+	Assert(cell.Set(1).IsOk(), "Set")
+
+	result := cell.Set(2)
+	Assert(result.IsErr(), "Set after initialization")
+	AssertEq(result.UnwrapErr().(OnceLockSetError[I32]).Value(), I32(2), "OnceLockSetError carries the value")
+	AssertEq(cell.GetOrInit(func() I32 { return 3 }), I32(1), "GetOrInit after initialization")
+	AssertEq(cell.Take(), Some[I32](1), "Take")
+	AssertEq(cell.IntoInner(), None[I32](), "Take leaves the cell uninitialized")
+
+	Assert(CatchUnwind(func() I32 { return cell.GetOrInit(func() I32 { panic("oh no") }) }).IsErr(), "GetOrInit propagates the panic")
+	AssertEq(cell.GetOrInit(func() I32 { return 4 }), I32(4), "a panicked GetOrInit leaves the cell uninitialized")
+
+	calls := 0
+	lazy := LazyLockNew(func() String { calls++; return "computed" })
+	AssertEq(lazy.Get(), String("computed"), "LazyLock")
+	AssertEq(lazy.Get(), String("computed"), "LazyLock")
+	Assert(Bool(calls == 1), "LazyLock computes once")
+}
+
+func Test_Condvar(t *testing.T) {
+	t.Parallel()
+
+	ready := MutexNew(false)
+	condvar := CondvarNew[bool]()
+
+	Spawn(func() {
+		Sleep(DurationFromMillis(10))
+		ready.WithLock(func(value *bool) { *value = true })
+		condvar.NotifyAll()
+	})
+
+	guard := condvar.WaitWhile(ready.Lock().Unwrap(), func(value *bool) Bool { return Bool(!*value) }).Unwrap()
+	Assert(Bool(guard.Get()), "WaitWhile returns once the condition is false")
+
+	waited := condvar.WaitTimeout(guard, DurationFromMillis(10)).Unwrap()
+	Assert(waited.Value.TimedOut(), "WaitTimeout without a notification")
+	Assert(Bool(waited.Key.Get()), "the guard is locked again after WaitTimeout")
+
+	Spawn(func() {
+		for {
+			// Notify once the waiter has released the lock to wait.
+			if result := ready.TryLock(); result.IsOk() {
+				result.Unwrap().Drop()
+				condvar.NotifyOne()
+				return
+			}
+			Sleep(DurationFromMillis(1))
+		}
+	})
+	notified := condvar.WaitTimeout(guard, DurationFromSecs(10)).Unwrap()
+	Assert(!notified.Value.TimedOut(), "WaitTimeout with a notification")
+	guard.Drop()
+}
+
+func Test_Barrier(t *testing.T) {
+	t.Parallel()
+
+	barrier := BarrierNew(4)
+	leaders := MutexNew[I32](0)
+
+	for round := 0; round < 2; round++ {
+		Scope(func(scope *ThreadScope) {
+			for i := 0; i < 4; i++ {
+				scope.Spawn(func() {
+					if barrier.Wait().IsLeader() {
+						leaders.WithLock(func(value *I32) { *value += 1 })
+					}
+				})
+			}
+		})
+	}
+
+	AssertEq(leaders.IntoInner().Unwrap(), I32(2), "one leader per group")
+}
+
+func Test_OnceLock_ConcurrentTake(t *testing.T) {
+	t.Parallel()
+
+	cell := OnceLockNew[I32]()
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		for i := 0; i < 1000; i++ {
+			cell.Set(I32(i))
+			cell.Take()
+		}
+	}()
+
+	for i := 0; i < 1000; i++ {
+		cell.Get()
+	}
+
+	<-done
+}
@@ -1,7 +1,8 @@
 package gost
 
 import (
-	"fmt"
+	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -9,27 +10,26 @@ import (
 //
 //	gost.Sleep(gost.DurationFromSecs(5)) // Sleep for 5 seconds
 func Sleep(dur Duration) {
-	durationString := fmt.Sprintf("%ds%dns", dur.seconds, dur.nanoseconds)
-
-	duration, err := time.ParseDuration(durationString)
-
-	if err != nil {
-		panic(err)
-	}
-
-	time.Sleep(duration)
+	time.Sleep(dur.ToGoDuration())
 }
 
 // An owned permission to join on a thread (block on its termination).
 type JoinHandle struct {
 	channel chan Unit
+	result  *Result[Unit]
+	joined  *atomic.Bool
 }
 
 // Waits for the associated thread to finish.
+// Returns an Err of PanicError, with the panic payload and stack, if the thread panicked.
+//
+//	handle := gost.Spawn(func() { panic("oh no") })
+//	gost.Assert(handle.Join().IsErr())
 func (self JoinHandle) Join() Result[Unit] {
 	<-self.channel
+	self.joined.Store(true)
 
-	return Ok(Unit{})
+	return *self.result
 }
 
 // Checks if the associated thread has finished running its main function.
@@ -43,15 +43,84 @@ func (self JoinHandle) IsFinished() bool {
 }
 
 // Spawns a new thread, returning a JoinHandle for it.
+// A panic in f does not crash the process; it is reported by JoinHandle.Join.
 func Spawn(f func()) JoinHandle {
 	channel := make(chan Unit)
+	result := &Result[Unit]{}
 
 	go func() {
-		f()
-		channel <- Unit{}
+		defer close(channel)
+
+		*result = CatchUnwind(func() Unit {
+			f()
+			return Unit{}
+		})
 	}()
 
 	return JoinHandle{
 		channel,
+		result,
+		&atomic.Bool{},
+	}
+}
+
+// A scope to spawn threads in, created by Scope.
+type ThreadScope struct {
+	lock    sync.Mutex
+	wait    sync.WaitGroup
+	handles []JoinHandle
+}
+
+// Creates a scope for spawning threads, and calls f with it.
+// Every thread spawned in the scope is joined before Scope returns, whether or not it was joined manually.
+// If f panics, the panic is propagated once every thread has finished. Otherwise, if a thread panicked and was not joined manually, Scope panics with its PanicError.
+//
+//	counter := &atomic.Int64{}
+//	gost.Scope(func(scope *gost.ThreadScope) {
+//		for i := 0; i < 4; i++ {
+//			scope.Spawn(func() { counter.Add(1) })
+//		}
+//	})
+//	gost.Assert(counter.Load() == 4)
+func Scope(f func(scope *ThreadScope)) {
+	scope := &ThreadScope{}
+
+	result := CatchUnwind(func() Unit {
+		f(scope)
+		return Unit{}
+	})
+
+	scope.wait.Wait()
+
+	if result.IsErr() {
+		panic(result.UnwrapErr().(PanicError).Payload())
+	}
+
+	scope.lock.Lock()
+	defer scope.lock.Unlock()
+
+	for _, handle := range scope.handles {
+		<-handle.channel
+
+		if !handle.joined.Load() && bool(handle.result.IsErr()) {
+			panic(handle.result.UnwrapErr())
+		}
 	}
 }
+
+// Spawns a new thread within the scope, returning a JoinHandle for it.
+// The thread may itself spawn more threads in the same scope, and they are joined too.
+func (self *ThreadScope) Spawn(f func()) JoinHandle {
+	self.wait.Add(1)
+
+	handle := Spawn(func() {
+		defer self.wait.Done()
+		f()
+	})
+
+	self.lock.Lock()
+	self.handles = append(self.handles, handle)
+	self.lock.Unlock()
+
+	return handle
+}
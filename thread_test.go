@@ -0,0 +1,65 @@
+package gost
+
+import (
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func Test_Spawn_Panic(t *testing.T) {
+	t.Parallel()
+
+	handle := Spawn(func() {})
+	Assert(handle.Join().IsOk(), "Join")
+	Assert(Bool(handle.IsFinished()), "IsFinished")
+	Assert(handle.Join().IsOk(), "Join after IsFinished")
+
+	handle = Spawn(func() { panic("oh no") })
+	result := handle.Join()
+	Assert(result.IsErr(), "Join returns the panic")
+
+	var panicError PanicError
+	Assert(Bool(errors.As(result.UnwrapErr(), &panicError)), "the error is a PanicError")
+	Assert(Bool(panicError.Payload() == "oh no"), "Payload")
+	Assert(Bool(strings.Contains(string(panicError.Stack()), "Test_Spawn_Panic")), "Stack")
+}
+
+func Test_CatchUnwind(t *testing.T) {
+	t.Parallel()
+
+	AssertEq(CatchUnwind(func() I32 { return 1 }), Ok[I32](1), "CatchUnwind without a panic")
+
+	cause := errors.New("cause")
+	result := CatchUnwind(func() I32 { panic(cause) })
+	Assert(result.IsErr(), "CatchUnwind with a panic")
+	Assert(Bool(errors.Is(result.UnwrapErr(), cause)), "PanicError unwraps an error payload")
+	AssertEq(String(result.UnwrapErr().Error()), String("panicked: cause"), "Error")
+}
+
+func Test_Scope(t *testing.T) {
+	t.Parallel()
+
+	counter := &atomic.Int64{}
+	Scope(func(scope *ThreadScope) {
+		for i := 0; i < 4; i++ {
+			scope.Spawn(func() {
+				scope.Spawn(func() { counter.Add(1) })
+				counter.Add(1)
+			})
+		}
+	})
+	Assert(Bool(counter.Load() == 8), "every thread is joined before Scope returns")
+
+	Scope(func(scope *ThreadScope) {
+		Assert(scope.Spawn(func() { panic("joined") }).Join().IsErr(), "a joined panic is not propagated")
+	})
+
+	defer func() {
+		_, ok := recover().(PanicError)
+		Assert(Bool(ok), "Scope propagates the panic of a thread that was not joined")
+	}()
+	Scope(func(scope *ThreadScope) {
+		scope.Spawn(func() { panic("oh no") })
+	})
+}
@@ -1,5 +1,10 @@
 package gost
 
+import (
+	"fmt"
+	"time"
+)
+
 // A Duration type to represent a span of time, typically used for system timeouts.
 // Each Duration is composed of a whole number of seconds and a fractional part represented in nanoseconds. If the underlying system does not support nanosecond-level precision, APIs binding a system timeout will typically round up the number of nanoseconds.
 type Duration struct {
@@ -90,3 +95,125 @@ func (self Duration) AsMicros() U128 {
 func (self Duration) AsNanos() U128 {
 	return U128_FromU64(self.seconds).Mul(U128_FromU64(U64(_NANOS_PER_SEC))).Add(U128_FromU64(U64(self.nanoseconds)))
 }
+
+// Converts this Duration to a time.Duration, for use with the standard library. Durations too long for a time.Duration, about 292 years, saturate at the largest one.
+//
+//	timer := time.NewTimer(gost.DurationFromSecs(1).ToGoDuration())
+func (self Duration) ToGoDuration() time.Duration {
+	const maxSeconds = U64(1<<63-1) / U64(time.Second)
+
+	if self.seconds >= maxSeconds {
+		return time.Duration(1<<63 - 1)
+	}
+
+	return time.Duration(self.seconds)*time.Second + time.Duration(self.nanoseconds)
+}
+
+// A measurement of the system clock, useful for talking to external entities like the file system or other processes.
+// Unlike a monotonic clock, the system clock can go backwards, so DurationSince returns a Result.
+type SystemTime struct {
+	time time.Time
+}
+
+// An anchor in time which can be used to create new SystemTime instances or learn about where in time a SystemTime lies.
+// It is defined to be "1970-01-01 00:00:00 UTC" on all systems.
+var UNIX_EPOCH = SystemTime{time: time.Unix(0, 0).UTC()}
+
+// Returns the system time corresponding to "now".
+func SystemTimeNow() SystemTime {
+	return SystemTime{time: time.Now()}
+}
+
+func _SystemTimeFromGo(value time.Time) SystemTime {
+	// Dropping the monotonic reading makes the SystemTime compare by wall clock only.
+	return SystemTime{time: value.Round(0)}
+}
+
+// Returns the amount of time elapsed from an earlier point in time.
+// Returns an Err if earlier is later than self; the error contains how far from self the time is.
+//
+//	elapsed := gost.SystemTimeNow().DurationSince(gost.UNIX_EPOCH).Unwrap()
+func (self SystemTime) DurationSince(earlier SystemTime) Result[Duration] {
+	if self.time.Before(earlier.time) {
+		return Err[Duration](SystemTimeError{duration: earlier.DurationSince(self).Unwrap()})
+	}
+
+	seconds := self.time.Unix() - earlier.time.Unix()
+	nanoseconds := int64(self.time.Nanosecond()) - int64(earlier.time.Nanosecond())
+
+	if nanoseconds < 0 {
+		seconds -= 1
+		nanoseconds += int64(_NANOS_PER_SEC)
+	}
+
+	return Ok(DurationNew(U64(seconds), U32(nanoseconds)))
+}
+
+// Returns the difference between the clock time when this SystemTime was created, and the current clock time.
+func (self SystemTime) Elapsed() Result[Duration] {
+	return SystemTimeNow().DurationSince(self)
+}
+
+// Returns Some(t) where t is the time self + duration if t can be represented, None otherwise.
+func (self SystemTime) CheckedAdd(duration Duration) Option[SystemTime] {
+	seconds := I64(self.time.Unix()).CheckedAdd(I64(duration.seconds))
+
+	if duration.seconds > U64(I64_MAX) || seconds.IsNone() {
+		return None[SystemTime]()
+	}
+
+	return Some(SystemTime{time: time.Unix(int64(seconds.Unwrap()), int64(self.time.Nanosecond())+int64(duration.nanoseconds)).In(self.time.Location())})
+}
+
+// Returns Some(t) where t is the time self - duration if t can be represented, None otherwise.
+func (self SystemTime) CheckedSub(duration Duration) Option[SystemTime] {
+	seconds := I64(self.time.Unix()).CheckedSub(I64(duration.seconds))
+
+	if duration.seconds > U64(I64_MAX) || seconds.IsNone() {
+		return None[SystemTime]()
+	}
+
+	return Some(SystemTime{time: time.Unix(int64(seconds.Unwrap()), int64(self.time.Nanosecond())-int64(duration.nanoseconds)).In(self.time.Location())})
+}
+
+// impl Eq for SystemTime
+func (self SystemTime) Eq(other SystemTime) Bool {
+	return Bool(self.time.Equal(other.time))
+}
+
+// impl Ord for SystemTime
+func (self SystemTime) Cmp(other SystemTime) Ordering {
+	if self.time.Before(other.time) {
+		return OrderingLess
+	} else if self.time.After(other.time) {
+		return OrderingGreater
+	} else {
+		return OrderingEqual
+	}
+}
+
+// impl Display for SystemTime
+// The time is shown in UTC, in RFC 3339 format.
+func (self SystemTime) Display() String {
+	return String(self.time.UTC().Format(time.RFC3339Nano))
+}
+
+// impl Debug for SystemTime
+func (self SystemTime) Debug() String {
+	return String(fmt.Sprintf("SystemTime { tv_sec: %d, tv_nsec: %d }", self.time.Unix(), self.time.Nanosecond()))
+}
+
+// An error returned from SystemTime.DurationSince and SystemTime.Elapsed, when the second system time represents a point later than the first.
+type SystemTimeError struct {
+	duration Duration
+}
+
+// Returns the positive duration which represents how far forward the second system time was from the first.
+func (self SystemTimeError) Duration() Duration {
+	return self.duration
+}
+
+// impl error for SystemTimeError
+func (self SystemTimeError) Error() string {
+	return "second time provided was later than self"
+}
@@ -0,0 +1,99 @@
+package gost
+
+// The panic value used by Result.Try to carry an Err up to the nearest Catch.
+type _TryErr struct {
+	err       error
+	backtrace Option[Backtrace]
+}
+
+// Describes an Err that escaped because no Catch was deferred.
+func (self _TryErr) Error() string {
+	return "Result.Try propagated an Err without a deferred Catch: " + self.err.Error()
+}
+
+// The panic value used by Option.Try to carry a None up to the nearest CatchOption.
+type _TryNone struct{}
+
+// Describes a None that escaped because no CatchOption was deferred.
+func (self _TryNone) Error() string {
+	return "Option.Try propagated a None without a deferred CatchOption"
+}
+
+// Returns the contained Ok value, or propagates the Err to the nearest deferred Catch, like the ? operator in Rust.
+// The calling function must return a Result and defer Catch on it; otherwise the Err escapes as a panic.
+//
+//	func parseSum(a gost.String, b gost.String) (result gost.Result[gost.I64]) {
+//		defer gost.Catch(&result)
+//
+//		return gost.Ok(a.ParseI64().Try() + b.ParseI64().Try())
+//	}
+func (self Result[T]) Try() T {
+	if self.IsErr() {
+		panic(_TryErr{err: self.err, backtrace: self.backtrace})
+	}
+
+	return *self.ok
+}
+
+// Returns the contained Some value, or propagates the None to the nearest deferred CatchOption, like the ? operator in Rust.
+// The calling function must return an Option and defer CatchOption on it; otherwise the None escapes as a panic.
+//
+//	func firstEven(vec gost.Vec[gost.I32]) (option gost.Option[gost.I32]) {
+//		defer gost.CatchOption(&option)
+//
+//		return gost.Some(vec.IntoIter().Find(func(e gost.I32) gost.Bool { return e%2 == 0 }).Try() * 10)
+//	}
+func (self Option[T]) Try() T {
+	if self.IsNone() {
+		panic(_TryNone{})
+	}
+
+	return *self.value
+}
+
+// Recovers an Err propagated by Result.Try and stores it in out.
+// It must be deferred directly by the function that owns out. Any other panic, including a None propagated by Option.Try, is re-raised untouched.
+//
+//	func readConfig(path gost.String) (result gost.Result[gost.String]) {
+//		defer gost.Catch(&result)
+//
+//		content := gost.ReadToString(path).Context("failed to read config").Try()
+//		return gost.Ok(content)
+//	}
+func Catch[T any](out *Result[T]) {
+	recovered := recover()
+
+	if recovered == nil {
+		return
+	}
+
+	if tryErr, ok := recovered.(_TryErr); ok {
+		*out = Result[T]{err: tryErr.err, backtrace: tryErr.backtrace}
+		return
+	}
+
+	panic(recovered)
+}
+
+// Recovers a None propagated by Option.Try and stores it in out.
+// It must be deferred directly by the function that owns out. Any other panic, including an Err propagated by Result.Try, is re-raised untouched.
+//
+//	func firstEven(vec gost.Vec[gost.I32]) (option gost.Option[gost.I32]) {
+//		defer gost.CatchOption(&option)
+//
+//		return gost.Some(vec.IntoIter().Find(func(e gost.I32) gost.Bool { return e%2 == 0 }).Try() * 10)
+//	}
+func CatchOption[T any](out *Option[T]) {
+	recovered := recover()
+
+	if recovered == nil {
+		return
+	}
+
+	if _, ok := recovered.(_TryNone); ok {
+		*out = None[T]()
+		return
+	}
+
+	panic(recovered)
+}
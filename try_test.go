@@ -0,0 +1,94 @@
+package gost
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+func _ParseSum(a String, b String) (result Result[I64]) {
+	defer Catch(&result)
+
+	return Ok(a.ParseI64().Try() + b.ParseI64().Try())
+}
+
+func _ParseSumOfPairs(a String, b String, c String) (result Result[I64]) {
+	defer Catch(&result)
+
+	// The inner call catches its own Err, and Try on its Result propagates it further.
+	sum := _ParseSum(a, b).Try()
+	return Ok(sum + c.ParseI64().Context("third operand").Try())
+}
+
+func _Half(value I32) (option Option[I32]) {
+	defer CatchOption(&option)
+
+	even := Some(value).Filter(func(e I32) Bool { return e%2 == 0 }).Try()
+	return Some(even / 2)
+}
+
+func Test_Try_Catch(t *testing.T) {
+	t.Parallel()
+
+	AssertEq(_ParseSum("1", "2"), Ok[I64](3), "Try on Ok")
+	Assert(_ParseSum("1", "x").IsErr(), "Try on Err")
+
+	AssertEq(_ParseSumOfPairs("1", "2", "3"), Ok[I64](6), "nested Ok")
+	Assert(_ParseSumOfPairs("x", "2", "3").IsErr(), "nested Err from the inner call")
+
+	err := _ParseSumOfPairs("1", "2", "x").UnwrapErr()
+	AssertEq(String(err.Error())[:13], String("third operand"), "Err keeps its context")
+
+	AssertEq(_Half(4), Some[I32](2), "Option Try on Some")
+	Assert(_Half(3).IsNone(), "Option Try on None")
+}
+
+func Test_Try_Catch_PropagatesAcrossCalls(t *testing.T) {
+	t.Parallel()
+
+	inner := func() I32 {
+		// No Catch here: the Err unwinds to the caller's Catch.
+		return Err[I32](io.EOF).Try()
+	}
+
+	outer := func() (result Result[I32]) {
+		defer Catch(&result)
+		return Ok(inner() + 1)
+	}
+
+	Assert(Bool(errors.Is(outer().UnwrapErr(), io.EOF)), "Err unwinds through functions without Catch")
+}
+
+func Test_Try_Catch_ForeignPanic(t *testing.T) {
+	t.Parallel()
+
+	foreign := func() (result Result[I32]) {
+		defer Catch(&result)
+		panic("boom")
+	}
+
+	defer func() {
+		Assert(recover() == "boom", "non-gost panics pass through Catch untouched")
+	}()
+
+	foreign()
+	t.Fatal("unreachable")
+}
+
+func Test_Try_CatchOption_IgnoresErr(t *testing.T) {
+	t.Parallel()
+
+	mixed := func() (option Option[I32]) {
+		defer CatchOption(&option)
+		return Some(Err[I32](io.EOF).Try())
+	}
+
+	defer func() {
+		err, ok := recover().(error)
+		Assert(Bool(ok), "an Err is not swallowed by CatchOption")
+		Assert(Bool(strings.Contains(err.Error(), "without a deferred Catch")), "the escaped panic describes the missing Catch")
+	}()
+
+	mixed()
+}
@@ -3,7 +3,17 @@ package gost
 import "reflect"
 
 func getTypeName[T any](value T) string {
-	return reflect.TypeOf(value).Elem().Name()
+	reflectedType := reflect.TypeOf(value)
+
+	if reflectedType == nil {
+		return "nil"
+	}
+
+	if reflectedType.Kind() == reflect.Pointer {
+		return reflectedType.Elem().Name()
+	}
+
+	return reflectedType.Name()
 }
 
 func _Swap[T any](lhs *T, rhs *T) {
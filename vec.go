@@ -526,18 +526,22 @@ func (self *Vec[T]) SortUnstableBy(compare func(T, T) Ordering) {
 
 // iterator for Vec
 type VecIter[T any] struct {
+	_IterBase[T]
 	vec      Vec[T]
 	position USize
+	end      USize
 }
 
 // into_iter
 func (self Vec[T]) IntoIter() Iterator[T] {
-	return &VecIter[T]{vec: self, position: 0}
+	iter := &VecIter[T]{vec: self, position: 0, end: self.Len()}
+	iter._IterBase = _IterBase[T]{iter}
+	return iter
 }
 
 // next
 func (self *VecIter[T]) Next() Option[T] {
-	if self.position >= self.vec.Len() {
+	if self.position >= self.end {
 		return None[T]()
 	}
 
@@ -547,89 +551,43 @@ func (self *VecIter[T]) Next() Option[T] {
 	return Some[T](value)
 }
 
-// map
-func (self VecIter[T]) Map(f func(T) T) Iterator[T] {
-	newVec := VecNew[T]()
-
-	for {
-		value := self.Next()
-
-		if value.IsNone() {
-			return newVec.IntoIter()
-		}
-		newVec.Push(f(value.Unwrap()))
-	}
-}
-
-// filter
-func (self VecIter[T]) Filter(f func(T) Bool) Iterator[T] {
-	newVec := VecNew[T]()
-
-	for {
-		value := self.Next()
-
-		if value.IsNone() {
-			return newVec.IntoIter()
-		}
-
-		unwraped := value.Unwrap()
-		if f(unwraped) {
-			newVec.Push(unwraped)
-		}
+// next_back
+func (self *VecIter[T]) NextBack() Option[T] {
+	if self.position >= self.end {
+		return None[T]()
 	}
-}
 
-// fold
-func (self VecIter[T]) Fold(init T, f func(T, T) T) T {
-	for {
-		value := self.Next()
+	self.end--
 
-		if value.IsNone() {
-			return init
-		}
-
-		init = f(init, value.Unwrap())
-	}
+	return Some[T](self.vec.GetUnchecked(self.end))
 }
 
-// rev
-func (self VecIter[T]) Rev() Iterator[T] {
-	newVec := VecWithLen[T](self.vec.Len())
-	i := self.vec.Len() - 1
+// Extends a collection with the contents of an iterator.
+//
+//	vec := gost.VecNew[gost.I32]()
+//	vec.Push(1)
+//	other := gost.VecNew[gost.I32]()
+//	other.Push(2)
+//	vec.Extend(other)
+//	gost.AssertEq(vec.Len(), gost.USize(2))
+func (self *Vec[T]) Extend(iter IntoIterator[T]) {
+	iterator := iter.IntoIter()
 
 	for {
-		value := self.Next()
+		value := iterator.Next()
 
 		if value.IsNone() {
-			return newVec.IntoIter()
+			return
 		}
-		newVec.AsSlice()[i] = value.Unwrap()
-		i--
-	}
-}
 
-// collect to Vec
-func (self VecIter[T]) CollectToVec() Vec[T] {
-	vec := Vec[T]{}
-	for {
-		value := self.Next()
-		if value.IsNone() {
-			return vec
-		}
-		vec.Push(value.Unwrap())
+		self.Push(value.Unwrap())
 	}
 }
 
-// collect to LinkedList
-func (self VecIter[T]) CollectToLinkedList() LinkedList[T] {
-	list := LinkedListNew[T]()
-	for {
-		value := self.Next()
-		if value.IsNone() {
-			return list
-		}
-		list.PushBack(value.Unwrap())
-	}
+// impl FromIterator for Vec
+func (self *Vec[T]) FromIter(iter Iterator[T]) {
+	*self = VecNew[T]()
+	self.Extend(iter)
 }
 
 // impl Display for Vec
@@ -697,18 +697,22 @@ func (self VecDeque[T]) _ToPhysicalIndex(index USize) USize {
 
 // iterator for VecDeque
 type VecDequeIter[T any] struct {
+	_IterBase[T]
 	deque    *VecDeque[T]
 	position USize
+	end      USize
 }
 
 // into_iter
 func (self VecDeque[T]) IntoIter() Iterator[T] {
-	return &VecDequeIter[T]{deque: &self, position: 0}
+	iter := &VecDequeIter[T]{deque: &self, position: 0, end: self.Len()}
+	iter._IterBase = _IterBase[T]{iter}
+	return iter
 }
 
 // next
 func (self *VecDequeIter[T]) Next() Option[T] {
-	if self.position >= self.deque.Len() {
+	if self.position >= self.end {
 		return None[T]()
 	}
 
@@ -718,73 +722,42 @@ func (self *VecDequeIter[T]) Next() Option[T] {
 	return value
 }
 
-// map
-func (self *VecDequeIter[T]) Map(f func(T) T) Iterator[T] {
-	newDeque := VecDequeWithCapacity[T](self.deque.Capacity())
-
-	for i := USize(0); i < self.deque.Len(); i++ {
-		newDeque.PushBack(f(self.deque.Get(i).Unwrap()))
-	}
-
-	return newDeque.IntoIter()
-}
-
-// filter
-func (self *VecDequeIter[T]) Filter(f func(T) Bool) Iterator[T] {
-	newDeque := VecDequeWithCapacity[T](self.deque.Capacity())
-
-	for i := USize(0); i < self.deque.Len(); i++ {
-		value := self.deque.Get(i).Unwrap()
-		if f(value) {
-			newDeque.PushBack(value)
-		}
-	}
-
-	return newDeque.IntoIter()
-}
-
-// fold
-func (self *VecDequeIter[T]) Fold(initial T, f func(T, T) T) T {
-	accumulator := initial
-
-	for i := USize(0); i < self.deque.Len(); i++ {
-		accumulator = f(accumulator, self.deque.Get(i).Unwrap())
+// next_back
+func (self *VecDequeIter[T]) NextBack() Option[T] {
+	if self.position >= self.end {
+		return None[T]()
 	}
 
-	return accumulator
-}
-
-// rev
-func (self *VecDequeIter[T]) Rev() Iterator[T] {
-	newDeque := VecDequeWithCapacity[T](self.deque.Capacity())
+	self.end--
 
-	for i := self.deque.Len().WrappingSub(1); i >= 0; i-- {
-		newDeque.PushBack(self.deque.Get(i).Unwrap())
-	}
-
-	return newDeque.IntoIter()
+	return self.deque.Get(self.end)
 }
 
-// collect to Vec
-func (self *VecDequeIter[T]) CollectToVec() Vec[T] {
-	newVec := VecWithCapacity[T](self.deque.Capacity())
+// Extends a collection with the contents of an iterator.
+//
+//	deque := gost.VecDequeNew[gost.I32]()
+//	vec := gost.VecNew[gost.I32]()
+//	vec.Push(1)
+//	deque.Extend(vec)
+//	gost.AssertEq(deque.Back(), gost.Some[gost.I32](1))
+func (self *VecDeque[T]) Extend(iter IntoIterator[T]) {
+	iterator := iter.IntoIter()
+
+	for {
+		value := iterator.Next()
+
+		if value.IsNone() {
+			return
+		}
 
-	for i := USize(0); i < self.deque.Len(); i++ {
-		newVec.Push(self.deque.Get(i).Unwrap())
+		self.PushBack(value.Unwrap())
 	}
-
-	return newVec
 }
 
-// collect to LinkedList
-func (self *VecDequeIter[T]) CollectToLinkedList() LinkedList[T] {
-	newLinkedList := LinkedListNew[T]()
-
-	for i := USize(0); i < self.deque.Len(); i++ {
-		newLinkedList.PushBack(self.deque.Get(i).Unwrap())
-	}
-
-	return newLinkedList
+// impl FromIterator for VecDeque
+func (self *VecDeque[T]) FromIter(iter Iterator[T]) {
+	*self = VecDequeNew[T]()
+	self.Extend(iter)
 }
 
 // impl Display for VecDeque
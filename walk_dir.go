@@ -0,0 +1,163 @@
+package gost
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// A builder to create an iterator for recursively walking a directory.
+// By default, every entry below the root is yielded, symbolic links are not followed, and there is no depth limit.
+//
+//	iter := gost.WalkDirNew("src").MaxDepth(2).IntoIter()
+//	for entry := iter.Next(); entry.IsSome(); entry = iter.Next() {
+//		gost.Println("{}", entry.Unwrap().Unwrap().Path)
+//	}
+type WalkDir struct {
+	root        Path
+	minDepth    USize
+	maxDepth    USize
+	followLinks bool
+}
+
+// Creates a builder for a recursive directory iterator starting at the file path root.
+// If root is a directory, it is yielded first, followed by its contents. If root is a symbolic link, it is always followed.
+func WalkDirNew[P AsPath](root P) WalkDir {
+	return WalkDir{root: PathOf(root), minDepth: 0, maxDepth: USize_MAX, followLinks: false}
+}
+
+// Sets the minimum depth of entries yielded by the iterator.
+// The root has depth 0, its direct children have depth 1, and so on.
+func (self WalkDir) MinDepth(depth USize) WalkDir {
+	self.minDepth = depth
+	return self
+}
+
+// Sets the maximum depth of entries yielded by the iterator. Directories at the maximum depth are yielded but not read.
+func (self WalkDir) MaxDepth(depth USize) WalkDir {
+	self.maxDepth = depth
+	return self
+}
+
+// Follows symbolic links. When enabled, the entry of a symbolic link describes its target, and links to directories are descended into.
+// A link back to one of its own ancestors is yielded as an Err instead of being descended into.
+func (self WalkDir) FollowLinks(follow Bool) WalkDir {
+	self.followLinks = bool(follow)
+	return self
+}
+
+// into_iter
+func (self WalkDir) IntoIter() Iterator[Result[WalkDirEntry]] {
+	iter := &WalkDirIter{options: self, started: false, stack: []_WalkDirFrame{}}
+	iter._IterBase = _IterBase[Result[WalkDirEntry]]{iter}
+	return iter
+}
+
+// A directory entry yielded by WalkDirIter.
+type WalkDirEntry struct {
+	DirEntry
+	// The depth at which this entry was found, relative to the root.
+	Depth USize
+}
+
+// An iterator for recursively descending into a directory, created by WalkDir.IntoIter.
+// Entries are yielded depth first, with the entries of each directory sorted by file name.
+// An error reading an entry or a directory is yielded as an Err, after which the walk continues.
+type WalkDirIter struct {
+	_IterBase[Result[WalkDirEntry]]
+	options WalkDir
+	started bool
+	stack   []_WalkDirFrame
+}
+
+// A directory whose entries are still being yielded.
+type _WalkDirFrame struct {
+	path    string
+	depth   USize
+	info    os.FileInfo
+	entries []os.DirEntry
+	err     error
+}
+
+// next
+func (self *WalkDirIter) Next() Option[Result[WalkDirEntry]] {
+	for {
+		var path string
+		var name string
+		var depth USize
+
+		if !self.started {
+			self.started = true
+			path = string(self.options.root)
+			name = filepath.Base(path)
+			depth = 0
+		} else {
+			if len(self.stack) == 0 {
+				return None[Result[WalkDirEntry]]()
+			}
+
+			top := &self.stack[len(self.stack)-1]
+
+			if top.err != nil {
+				err := top.err
+				top.err = nil
+				return Some(Err[WalkDirEntry](err))
+			}
+
+			if len(top.entries) == 0 {
+				self.stack = self.stack[:len(self.stack)-1]
+				continue
+			}
+
+			entry := top.entries[0]
+			top.entries = top.entries[1:]
+
+			path = filepath.Join(top.path, entry.Name())
+			name = entry.Name()
+			depth = top.depth + 1
+		}
+
+		info, err := os.Lstat(path)
+
+		if err == nil && info.Mode()&os.ModeSymlink != 0 && (self.options.followLinks || depth == 0) {
+			info, err = os.Stat(path)
+		}
+
+		if err != nil {
+			return Some(Err[WalkDirEntry](err))
+		}
+
+		if info.IsDir() && depth < self.options.maxDepth {
+			if ancestor := self.ancestor(info); ancestor.IsSome() {
+				return Some(Err[WalkDirEntry](fmt.Errorf("filesystem loop found: %s points to its ancestor %s", path, ancestor.Unwrap())))
+			}
+
+			entries, err := os.ReadDir(path)
+			self.stack = append(self.stack, _WalkDirFrame{path: path, depth: depth, info: info, entries: entries, err: err})
+		}
+
+		if depth < self.options.minDepth {
+			continue
+		}
+
+		return Some(Ok(WalkDirEntry{
+			DirEntry: DirEntry{FileName: String(name), Path: PathBufFrom(path), FileType: _FileTypeFromMode(info.Mode())},
+			Depth:    depth,
+		}))
+	}
+}
+
+// Returns the path of the directory being walked that is the same file as info, if any.
+func (self *WalkDirIter) ancestor(info os.FileInfo) Option[string] {
+	if !self.options.followLinks {
+		return None[string]()
+	}
+
+	for _, frame := range self.stack {
+		if os.SameFile(frame.info, info) {
+			return Some(frame.path)
+		}
+	}
+
+	return None[string]()
+}